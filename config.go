@@ -11,6 +11,7 @@ import (
 
 	"gioui.org/app"
 	"github.com/crypto-power/cryptopower/appos"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/version"
 	"github.com/decred/dcrd/dcrutil/v4"
@@ -22,6 +23,7 @@ const (
 	defaultConfigFileName = "cryptopower.conf"
 	defaultLogFilename    = "cryptopower.log"
 	defaultLogDirname     = "logs"
+	defaultRPCListen      = "127.0.0.1:9110"
 )
 
 type config struct {
@@ -36,8 +38,18 @@ type config struct {
 	SpendUnconfirmed bool   `long:"spendunconfirmed" description:"Allow the assetsManager to use transactions that have not been confirmed"`
 	Profile          int    `long:"profile" description:"Runs local web server for profiling"`
 	DEXTestAddr      string `long:"dextestaddr" description:"If using the dextest network, set an address for the dex harness to be used as a persistant peer for all new wallets."`
-
-	net libutils.NetworkType
+	PaymentURI       string `long:"uri" description:"Payment URI (bitcoin:, litecoin: or decred:) to fill the send form with once the app is unlocked"`
+
+	// Headless mode
+	Headless  bool   `long:"headless" description:"Run without the GUI and serve the wallets over a local JSON-RPC API. Implied by --rpclisten."`
+	RPCListen string `long:"rpclisten" description:"Address the JSON-RPC server listens on in headless mode (default 127.0.0.1:9110)"`
+	RPCUser   string `long:"rpcuser" description:"Username for JSON-RPC connections"`
+	RPCPass   string `long:"rpcpass" default-mask:"-" description:"Password for JSON-RPC connections"`
+	RPCCert   string `long:"rpccert" description:"File containing the certificate used to serve the JSON-RPC API over TLS"`
+	RPCKey    string `long:"rpckey" description:"File containing the private key used to serve the JSON-RPC API over TLS"`
+
+	net        libutils.NetworkType
+	paymentURI *uri.PaymentURI
 }
 
 func defaultConfig(defaultHomeDir string) config {
@@ -232,6 +244,37 @@ func loadConfig() (*config, error) {
 		return loadConfigError(fmt.Errorf("network type is not supported: %s", cfg.Network))
 	}
 
+	if cfg.PaymentURI != "" {
+		cfg.paymentURI, err = uri.Parse(cfg.PaymentURI)
+		if err != nil {
+			return loadConfigError(err)
+		}
+	}
+
+	// Validate the headless mode options. Providing a listen address is enough
+	// to run headless.
+	if cfg.RPCListen != "" {
+		cfg.Headless = true
+	}
+	if cfg.Headless {
+		if appos.Current().IsMobile() {
+			return loadConfigError(fmt.Errorf("headless mode is not supported on mobile"))
+		}
+		if cfg.RPCListen == "" {
+			cfg.RPCListen = defaultRPCListen
+		}
+		if cfg.RPCUser == "" || cfg.RPCPass == "" {
+			return loadConfigError(fmt.Errorf("--rpcuser and --rpcpass are required in headless mode"))
+		}
+		if (cfg.RPCCert == "") != (cfg.RPCKey == "") {
+			return loadConfigError(fmt.Errorf("--rpccert and --rpckey must be set together"))
+		}
+		if cfg.RPCCert != "" {
+			cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
+			cfg.RPCKey = cleanAndExpandPath(cfg.RPCKey)
+		}
+	}
+
 	// Parse, validate, and set debug log level(s).
 	if cfg.Quiet {
 		cfg.DebugLevel = "error"
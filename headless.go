@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/rpcserver"
+	"github.com/crypto-power/cryptopower/ui/load"
+)
+
+// runHeadless starts the AssetsManager without the GUI and serves the wallets
+// over JSON-RPC until the process is interrupted.
+func runHeadless(cfg *config, appCfg *load.AppConfig, initAssetsManager load.AssetsManagerInitFn) error {
+	netType := cfg.Network
+	if netType == "" {
+		netType = appCfg.Values().NetType
+	}
+	net := utils.ToNetworkType(netType)
+	if net == utils.Unknown {
+		return fmt.Errorf("invalid netType: %s", netType)
+	}
+
+	assetsManager, err := initAssetsManager(net)
+	if err != nil {
+		return fmt.Errorf("init assetsManager error: %v", err)
+	}
+	defer assetsManager.Shutdown()
+
+	server, err := rpcserver.New(rpcserver.Config{
+		Listen:   cfg.RPCListen,
+		Username: cfg.RPCUser,
+		Password: cfg.RPCPass,
+		CertFile: cfg.RPCCert,
+		KeyFile:  cfg.RPCKey,
+	}, assetsManager)
+	if err != nil {
+		return err
+	}
+
+	// Wallets protected by a startup passphrase are opened later through the
+	// openwallets RPC.
+	if assetsManager.IsStartupSecuritySet() {
+		log.Info("Startup passphrase is set, call openwallets to open the wallets")
+	} else if err := server.OpenWallets(""); err != nil {
+		return fmt.Errorf("error opening wallets: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	log.Infof("Running headless on %s", net)
+	return server.Run(ctx)
+}
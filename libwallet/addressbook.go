@@ -0,0 +1,50 @@
+package libwallet
+
+import (
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/addressbook"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// AddContact saves address to the address book under name. The address must
+// be valid for the current network, which is checked with a wallet of
+// assetType.
+func (mgr *AssetsManager) AddContact(name string, assetType utils.AssetType, address string) (*addressbook.Contact, error) {
+	wallets := mgr.AssetWallets(assetType)
+	if len(wallets) == 0 {
+		return nil, errors.E(errors.Invalid, "no wallet to validate the address with")
+	}
+	if !wallets[0].IsAddressValid(address) {
+		return nil, errors.E(errors.Invalid, utils.ErrInvalidAddress)
+	}
+	return mgr.AddressBook.Add(name, assetType, address)
+}
+
+// IsContactValid returns false if the address of contact isn't valid for the
+// current network, e.g. because it was saved before the network parameters
+// of its asset changed. Contacts of assets without a wallet to check them
+// with are reported as valid.
+func (mgr *AssetsManager) IsContactValid(contact *addressbook.Contact) bool {
+	wallets := mgr.AssetWallets(contact.AssetType)
+	if len(wallets) == 0 {
+		return true
+	}
+	return wallets[0].IsAddressValid(contact.Address)
+}
+
+// InvalidContacts returns the contacts whose address isn't valid for the
+// current network.
+func (mgr *AssetsManager) InvalidContacts() ([]*addressbook.Contact, error) {
+	contacts, err := mgr.AddressBook.Contacts()
+	if err != nil {
+		return nil, err
+	}
+
+	var invalid []*addressbook.Contact
+	for _, contact := range contacts {
+		if !mgr.IsContactValid(contact) {
+			invalid = append(invalid, contact)
+		}
+	}
+	return invalid, nil
+}
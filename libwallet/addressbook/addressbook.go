@@ -0,0 +1,181 @@
+// Package addressbook stores the addresses of the counterparties a user pays
+// regularly, under a name of their choosing.
+package addressbook
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Contact is a named address of one of the supported assets.
+type Contact struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	AssetType utils.AssetType `json:"assetType"`
+	Address   string          `json:"address"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// AddressBook is the set of contacts shared by all the wallets of all the
+// networks. It's kept in a JSON file next to the network folders rather than
+// in a network's database, so the contacts are still listed after a network
+// switch; contacts whose address isn't valid for the current network must be
+// flagged by the caller, see AssetsManager.IsContactValid.
+//
+// The file is read on every call, so that the address books of the assets
+// managers of the old and the new network, which are both open during a
+// network switch, don't overwrite each other's changes.
+type AddressBook struct {
+	mtx          sync.Mutex
+	jsonFilePath string
+}
+
+// New returns the address book kept in the JSON file at jsonFilePath, which
+// is created when the first contact is added.
+func New(jsonFilePath string) (*AddressBook, error) {
+	ab := &AddressBook{jsonFilePath: jsonFilePath}
+	if _, err := ab.read(); err != nil {
+		return nil, err
+	}
+	return ab, nil
+}
+
+// read returns the contacts saved in the JSON file.
+func (ab *AddressBook) read() ([]*Contact, error) {
+	contactsJSON, err := os.ReadFile(ab.jsonFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Errorf("os.ReadFile error: %v", err)
+	}
+
+	var contacts []*Contact
+	if err = json.Unmarshal(contactsJSON, &contacts); err != nil {
+		return nil, errors.Errorf("unmarshal address book json error: %v", err)
+	}
+	return contacts, nil
+}
+
+// write replaces the contacts saved in the JSON file.
+func (ab *AddressBook) write(contacts []*Contact) error {
+	contactsJSON, err := json.Marshal(contacts)
+	if err != nil {
+		return errors.Errorf("json.Marshal error: %v", err)
+	}
+	if err = os.WriteFile(ab.jsonFilePath, contactsJSON, utils.UserFilePerm); err != nil {
+		return errors.Errorf("os.WriteFile error: %v", err)
+	}
+	return nil
+}
+
+// Add saves a new contact. Contact names are unique per asset.
+func (ab *AddressBook) Add(name string, assetType utils.AssetType, address string) (*Contact, error) {
+	name = strings.TrimSpace(name)
+	address = strings.TrimSpace(address)
+	if name == "" || address == "" {
+		return nil, errors.E(errors.Invalid, "contact name and address are required")
+	}
+
+	ab.mtx.Lock()
+	defer ab.mtx.Unlock()
+
+	contacts, err := ab.read()
+	if err != nil {
+		return nil, err
+	}
+
+	var lastID int
+	for _, existing := range contacts {
+		if existing.Name == name && existing.AssetType == assetType {
+			return nil, errors.E(errors.Exist, "a contact with this name already exists")
+		}
+		if existing.ID > lastID {
+			lastID = existing.ID
+		}
+	}
+
+	contact := &Contact{
+		ID:        lastID + 1,
+		Name:      name,
+		AssetType: assetType,
+		Address:   address,
+		CreatedAt: time.Now(),
+	}
+	if err = ab.write(append(contacts, contact)); err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+// Delete removes the contact with the provided ID.
+func (ab *AddressBook) Delete(id int) error {
+	ab.mtx.Lock()
+	defer ab.mtx.Unlock()
+
+	contacts, err := ab.read()
+	if err != nil {
+		return err
+	}
+
+	for i, contact := range contacts {
+		if contact.ID == id {
+			return ab.write(append(contacts[:i], contacts[i+1:]...))
+		}
+	}
+	return errors.E(errors.NotExist, "contact not found")
+}
+
+// Contacts returns the contacts of the provided assets, or of all assets if
+// none is provided, sorted by name.
+func (ab *AddressBook) Contacts(assetTypes ...utils.AssetType) ([]*Contact, error) {
+	ab.mtx.Lock()
+	contacts, err := ab.read()
+	ab.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*Contact, 0, len(contacts))
+	for _, contact := range contacts {
+		if len(assetTypes) == 0 || hasAssetType(assetTypes, contact.AssetType) {
+			filtered = append(filtered, contact)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered, nil
+}
+
+// ContactWithAddress returns the contact of assetType saved with address, or
+// nil if there's none.
+func (ab *AddressBook) ContactWithAddress(assetType utils.AssetType, address string) *Contact {
+	contacts, err := ab.Contacts(assetType)
+	if err != nil {
+		return nil
+	}
+	for _, contact := range contacts {
+		if contact.Address == address {
+			return contact
+		}
+	}
+	return nil
+}
+
+func hasAssetType(assetTypes []utils.AssetType, assetType utils.AssetType) bool {
+	for _, t := range assetTypes {
+		if t == assetType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package addressbook
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestAddressBook(t *testing.T) {
+	jsonFilePath := filepath.Join(t.TempDir(), "addressbook.json")
+	ab, err := New(jsonFilePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The address book of the assets manager of another network, open at the
+	// same time during a network switch.
+	other, err := New(jsonFilePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		book      *AddressBook
+		contact   string
+		assetType utils.AssetType
+		address   string
+		wantErr   bool
+	}{{
+		name:      "new contact",
+		book:      ab,
+		contact:   "Bob",
+		assetType: utils.DCRWalletAsset,
+		address:   "TsfDLrRkk9ciUuwfp2b8PawwnukYD7yAjGd",
+	}, {
+		name:      "same name of another asset",
+		book:      other,
+		contact:   "Bob",
+		assetType: utils.BTCWalletAsset,
+		address:   "tb1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+	}, {
+		name:      "duplicate name",
+		book:      other,
+		contact:   " Bob ",
+		assetType: utils.DCRWalletAsset,
+		address:   "TsfDLrRkk9ciUuwfp2b8PawwnukYD7yAjGd",
+		wantErr:   true,
+	}, {
+		name:      "missing address",
+		book:      ab,
+		contact:   "Alice",
+		assetType: utils.DCRWalletAsset,
+		wantErr:   true,
+	}, {
+		name:      "sorted before existing contacts",
+		book:      ab,
+		contact:   "Alice",
+		assetType: utils.DCRWalletAsset,
+		address:   "TsmWaPM77WSyA3aiQ2Q1KnwGDVWvEkhipBc",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.book.Add(test.contact, test.assetType, test.address)
+			if test.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+
+	contacts, err := other.Contacts(utils.DCRWalletAsset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contacts) != 2 || contacts[0].Name != "Alice" || contacts[1].Name != "Bob" {
+		t.Fatalf("unexpected DCR contacts %+v", contacts)
+	}
+
+	if contact := ab.ContactWithAddress(utils.BTCWalletAsset, "tb1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"); contact == nil || contact.Name != "Bob" {
+		t.Fatalf("unexpected BTC contact %+v", contact)
+	}
+
+	if err = other.Delete(contacts[1].ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = ab.Delete(contacts[1].ID); err == nil {
+		t.Fatal("expected an error deleting a deleted contact")
+	}
+	all, err := ab.Contacts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected 2 contacts left, got %d", len(all))
+	}
+}
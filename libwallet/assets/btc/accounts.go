@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -11,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 )
@@ -47,31 +49,44 @@ func (asset *Asset) GetAccountsRaw() (*sharedW.Accounts, error) {
 		return nil, utils.ErrBTCNotInitialized
 	}
 
-	resp, err := asset.Internal().BTC.Accounts(GetScope())
-	if err != nil {
-		return nil, err
-	}
-
-	accounts := make([]*sharedW.Account, len(resp.Accounts))
-	for i, a := range resp.Accounts {
-		balance, err := asset.GetAccountBalance(int32(a.AccountNumber))
+	var accounts []*sharedW.Account
+	var resp *wallet.AccountsResult
+	for _, scope := range asset.activeKeyScopes() {
+		scopeResp, err := asset.Internal().BTC.Accounts(scope)
 		if err != nil {
 			return nil, err
 		}
 
-		accounts[i] = &sharedW.Account{
-			AccountProperties: sharedW.AccountProperties{
-				AccountNumber:    a.AccountNumber,
-				AccountName:      a.AccountName,
-				ExternalKeyCount: a.ExternalKeyCount + AddressGapLimit, // Add gap limit
-				InternalKeyCount: a.InternalKeyCount + AddressGapLimit,
-				ImportedKeyCount: a.ImportedKeyCount,
-			},
-			Number:   int32(a.AccountNumber),
-			Name:     a.AccountName,
-			WalletID: asset.ID,
-			Balance:  balance,
+		for _, a := range scopeResp.Accounts {
+			// The default and imported accounts of the other scopes are
+			// listed as part of the default scope's.
+			if scope != GetScope() && (a.AccountNumber == DefaultAccountNum ||
+				a.AccountNumber == ImportedAccountNumber) {
+				continue
+			}
+
+			accountNumber := scopedAccountNumber(scope, a.AccountNumber)
+			balance, err := asset.GetAccountBalance(accountNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			accounts = append(accounts, &sharedW.Account{
+				AccountProperties: sharedW.AccountProperties{
+					AccountNumber:    uint32(accountNumber),
+					AccountName:      a.AccountName,
+					ExternalKeyCount: a.ExternalKeyCount + AddressGapLimit, // Add gap limit
+					InternalKeyCount: a.InternalKeyCount + AddressGapLimit,
+					ImportedKeyCount: a.ImportedKeyCount,
+					KeyScope:         sharedW.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin},
+				},
+				Number:   accountNumber,
+				Name:     a.AccountName,
+				WalletID: asset.ID,
+				Balance:  balance,
+			})
 		}
+		resp = scopeResp
 	}
 
 	return &sharedW.Accounts{
@@ -104,7 +119,7 @@ func (asset *Asset) GetAccountBalance(accountNumber int32) (*sharedW.Balance, er
 		return nil, utils.ErrBTCNotInitialized
 	}
 
-	balance, err := asset.Internal().BTC.CalculateAccountBalances(uint32(accountNumber), asset.RequiredConfirmations())
+	balance, err := asset.accountBalances(accountNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -115,9 +130,14 @@ func (asset *Asset) GetAccountBalance(accountNumber int32) (*sharedW.Balance, er
 		return nil, err
 	}
 
+	frozenAmount, err := asset.frozenAmount(accountNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	return &sharedW.Balance{
 		Total:          Amount(balance.Total),
-		Spendable:      Amount(balance.Spendable - lockedAmount),
+		Spendable:      Amount(balance.Spendable - lockedAmount - frozenAmount),
 		ImmatureReward: Amount(balance.ImmatureReward),
 		Locked:         Amount(lockedAmount),
 	}, nil
@@ -138,13 +158,74 @@ func (asset *Asset) lockedAmount() (btcutil.Amount, error) {
 	return btcutil.Amount(sum), nil
 }
 
+// accountBalances returns the balances of the provided account. btcwallet
+// attributes outputs to accounts by number alone, which the accounts of
+// different key scopes share, so the balances of accounts outside the default
+// scope are summed from their unspent outputs, and deducted from the default
+// scope account with the same number.
+func (asset *Asset) accountBalances(accountNumber int32) (wallet.Balances, error) {
+	scope, account := accountScope(accountNumber)
+	if scope != GetScope() {
+		return asset.scopedAccountBalances(scope, account)
+	}
+
+	bals, err := asset.Internal().BTC.CalculateAccountBalances(account, asset.RequiredConfirmations())
+	if err != nil || account == DefaultAccountNum || account == ImportedAccountNumber {
+		return bals, err
+	}
+
+	for _, otherScope := range asset.activeKeyScopes() {
+		if otherScope == scope {
+			continue
+		}
+		otherBals, err := asset.scopedAccountBalances(otherScope, account)
+		if err != nil {
+			return bals, err
+		}
+		bals.Total -= otherBals.Total
+		bals.Spendable -= otherBals.Spendable
+	}
+	return bals, nil
+}
+
+// scopedAccountBalances sums the unspent outputs of the account numbered
+// account in scope. Account names are unique across scopes, except for the
+// default account's.
+func (asset *Asset) scopedAccountBalances(scope waddrmgr.KeyScope, account uint32) (wallet.Balances, error) {
+	var bals wallet.Balances
+	accountName, err := asset.Internal().BTC.AccountName(scope, account)
+	if err != nil {
+		if waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound) {
+			return bals, nil
+		}
+		return bals, err
+	}
+
+	unspents, err := asset.Internal().BTC.ListUnspent(0, math.MaxInt32, accountName)
+	if err != nil {
+		return bals, err
+	}
+
+	for _, utxo := range unspents {
+		amount, err := btcutil.NewAmount(utxo.Amount)
+		if err != nil {
+			return bals, err
+		}
+		bals.Total += amount
+		if int32(utxo.Confirmations) >= asset.RequiredConfirmations() {
+			bals.Spendable += amount
+		}
+	}
+	return bals, nil
+}
+
 // SpendableForAccount returns the spendable balance for the provided account
 func (asset *Asset) SpendableForAccount(account int32) (int64, error) {
 	if !asset.WalletOpened() {
 		return -1, utils.ErrBTCNotInitialized
 	}
 
-	bals, err := asset.Internal().BTC.CalculateAccountBalances(uint32(account), asset.RequiredConfirmations())
+	bals, err := asset.accountBalances(account)
 	if err != nil {
 		return 0, utils.TranslateError(err)
 	}
@@ -155,7 +236,21 @@ func (asset *Asset) SpendableForAccount(account int32) (int64, error) {
 		return 0, err
 	}
 
-	return int64(bals.Spendable - lockedAmount), nil
+	frozenAmount, err := asset.frozenAmount(account)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(bals.Spendable - lockedAmount - frozenAmount), nil
+}
+
+// frozenAmount is the total value of the frozen outputs of the provided
+// account, which only manual coin selection can spend.
+func (asset *Asset) frozenAmount(account int32) (btcutil.Amount, error) {
+	amount, err := asset.FrozenAmount(func() ([]*sharedW.UnspentOutput, error) {
+		return asset.UnspentOutputs(account)
+	})
+	return btcutil.Amount(amount), err
 }
 
 // UnspentOutputs returns all the unspent outputs available for the provided
@@ -183,6 +278,11 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 		// and doesn't require an extra layer of validation.
 		amount, _ := btcutil.NewAmount(utxo.Amount)
 
+		pkScript, err := hex.DecodeString(utxo.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pkScript for %v:%d : error: %v", utxo.TxID, utxo.Vout, err)
+		}
+
 		txInfo, err := asset.GetTransactionRaw(utxo.TxID)
 		if err != nil {
 			return nil, fmt.Errorf("invalid TxID %v : error: %v", utxo.TxID, err)
@@ -196,28 +296,51 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 			RedeemScript:  utxo.RedeemScript,
 			Amount:        Amount(amount),
 			Confirmations: int32(utxo.Confirmations),
-			Spendable:     utxo.Spendable,
+			Spendable:     utxo.Spendable || asset.isScopedScript(pkScript),
 			ReceiveTime:   time.Unix(txInfo.Timestamp, 0),
 		})
 	}
 
+	if err := asset.MarkFrozenOutputs(resp); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
-// CreateNewAccount creates a new account with the provided account name.
+// CreateNewAccount creates a new account with the provided account name in
+// the default key scope.
 func (asset *Asset) CreateNewAccount(accountName, privPass string) (int32, error) {
-	err := asset.UnlockWallet(privPass)
+	return asset.CreateNewAccountWithScope(accountName, privPass, SupportedKeyScopes()[0])
+}
+
+// CreateNewAccountWithScope creates a new account with the provided account
+// name in the provided key scope, which must be one of SupportedKeyScopes.
+func (asset *Asset) CreateNewAccountWithScope(accountName, privPass string, scope sharedW.KeyScope) (int32, error) {
+	keyScope, err := toKeyScope(scope)
+	if err != nil {
+		return -1, err
+	}
+
+	err = asset.UnlockWallet(privPass)
 	if err != nil {
 		return -1, err
 	}
 
 	defer asset.LockWallet()
 
-	return asset.NextAccount(accountName)
+	return asset.nextAccount(keyScope, accountName)
 }
 
 // NextAccount returns the next account number for the provided account name.
 func (asset *Asset) NextAccount(accountName string) (int32, error) {
+	return asset.nextAccount(GetScope(), accountName)
+}
+
+// nextAccount creates the next account of the provided key scope. Account
+// names must be unique across all scopes, as the wallet looks up the outputs
+// of an account by its name.
+func (asset *Asset) nextAccount(scope waddrmgr.KeyScope, accountName string) (int32, error) {
 	if !asset.WalletOpened() {
 		return -1, utils.ErrBTCNotInitialized
 	}
@@ -226,12 +349,16 @@ func (asset *Asset) NextAccount(accountName string) (int32, error) {
 		return -1, errors.New(utils.ErrWalletLocked)
 	}
 
-	accountNumber, err := asset.Internal().BTC.NextAccount(GetScope(), accountName)
+	if asset.HasAccount(accountName) {
+		return -1, errors.New(utils.ErrExist)
+	}
+
+	accountNumber, err := asset.Internal().BTC.NextAccount(scope, accountName)
 	if err != nil {
 		return -1, err
 	}
 
-	return int32(accountNumber), nil
+	return scopedAccountNumber(scope, accountNumber), nil
 }
 
 // RenameAccount renames the account with the provided account number.
@@ -240,7 +367,12 @@ func (asset *Asset) RenameAccount(accountNumber int32, newName string) error {
 		return utils.ErrBTCNotInitialized
 	}
 
-	err := asset.Internal().BTC.RenameAccount(GetScope(), uint32(accountNumber), newName)
+	if asset.HasAccount(newName) {
+		return errors.New(utils.ErrExist)
+	}
+
+	scope, account := accountScope(accountNumber)
+	err := asset.Internal().BTC.RenameAccount(scope, account, newName)
 	if err != nil {
 		return utils.TranslateError(err)
 	}
@@ -264,7 +396,8 @@ func (asset *Asset) AccountNameRaw(accountNumber uint32) (string, error) {
 		return "", utils.ErrBTCNotInitialized
 	}
 
-	return asset.Internal().BTC.AccountName(GetScope(), accountNumber)
+	scope, account := accountScope(int32(accountNumber))
+	return asset.Internal().BTC.AccountName(scope, account)
 }
 
 // AccountNumber returns the account number for the provided account name.
@@ -273,28 +406,39 @@ func (asset *Asset) AccountNumber(accountName string) (int32, error) {
 		return -1, utils.ErrBTCNotInitialized
 	}
 
-	accountNumber, err := asset.Internal().BTC.AccountNumber(GetScope(), accountName)
-	return int32(accountNumber), utils.TranslateError(err)
+	err := errors.New(utils.ErrNotExist)
+	for _, scope := range asset.activeKeyScopes() {
+		var accountNumber uint32
+		accountNumber, err = asset.Internal().BTC.AccountNumber(scope, accountName)
+		if err == nil {
+			return scopedAccountNumber(scope, accountNumber), nil
+		}
+	}
+	return -1, utils.TranslateError(err)
 }
 
 // HasAccount returns true if there is an account with the provided account name.
 func (asset *Asset) HasAccount(accountName string) bool {
-	if !asset.WalletOpened() {
-		return false
-	}
-
-	_, err := asset.Internal().BTC.AccountNumber(GetScope(), accountName)
+	_, err := asset.AccountNumber(accountName)
 	return err == nil
 }
 
 // HDPathForAccount returns the HD path for the provided account number.
 func (asset *Asset) HDPathForAccount(accountNumber int32) (string, error) {
-	var hdPath string
+	scope, account := accountScope(accountNumber)
+	hdPath := fmt.Sprintf("m / %d' / 1' / ", scope.Purpose)
 	if asset.chainParams.Name == chaincfg.MainNetParams.Name {
-		hdPath = MainnetHDPath
-	} else {
-		hdPath = TestnetHDPath
+		hdPath = fmt.Sprintf("m / %d' / 0' / ", scope.Purpose)
 	}
 
-	return hdPath + strconv.Itoa(int(accountNumber)), nil
+	return hdPath + strconv.Itoa(int(account)), nil
+}
+
+// pruneFrozenOutputs removes the outputs spent since they were frozen from the
+// frozen outputs. It must only be called once the wallet is synced.
+func (asset *Asset) pruneFrozenOutputs() {
+	err := asset.PruneFrozenOutputs(asset.GetAccountsRaw, asset.UnspentOutputs, asset.UnfreezeOutput)
+	if err != nil {
+		log.Errorf("[%d] Error pruning frozen outputs: %v", asset.ID, err)
+	}
 }
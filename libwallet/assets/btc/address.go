@@ -67,13 +67,13 @@ func (asset *Asset) AddressInfo(address string) (*AddressInfo, error) {
 	if isMine {
 		addressInfo.IsMine = isMine
 
-		accountNumber, err := asset.Internal().BTC.AccountOfAddress(addr)
+		accountNumber, err := asset.addressAccountNumber(addr)
 		if err != nil {
 			return nil, err
 		}
-		addressInfo.AccountNumber = accountNumber
+		addressInfo.AccountNumber = uint32(accountNumber)
 
-		accountName, err := asset.AccountName(int32(accountNumber))
+		accountName, err := asset.AccountName(accountNumber)
 		if err != nil {
 			return nil, err
 		}
@@ -95,7 +95,8 @@ func (asset *Asset) CurrentAddress(account int32) (string, error) {
 		return "", utils.ErrBTCNotInitialized
 	}
 
-	addr, err := asset.Internal().BTC.CurrentAddress(uint32(account), GetScope())
+	scope, scopeAccount := accountScope(account)
+	addr, err := asset.Internal().BTC.CurrentAddress(scopeAccount, scope)
 	if err != nil {
 		log.Errorf("CurrentAddress error: %v", err)
 		return "", err
@@ -116,7 +117,8 @@ func (asset *Asset) NextAddress(account int32) (string, error) {
 	}
 
 	// NewAddress returns the next external chained address for a wallet.
-	address, err := asset.Internal().BTC.NewAddress(uint32(account), GetScope())
+	scope, scopeAccount := accountScope(account)
+	address, err := asset.Internal().BTC.NewAddress(scopeAccount, scope)
 	if err != nil {
 		log.Errorf("NewExternalAddress error: %w", err)
 		return "", err
@@ -136,12 +138,12 @@ func (asset *Asset) AccountOfAddress(address string) (string, error) {
 		return "", utils.ErrBTCNotInitialized
 	}
 
-	accountNumber, err := asset.Internal().BTC.AccountOfAddress(addr)
+	accountNumber, err := asset.addressAccountNumber(addr)
 	if err != nil {
 		return "", utils.TranslateError(err)
 	}
 
-	accountName, err := asset.AccountName(int32(accountNumber))
+	accountName, err := asset.AccountName(accountNumber)
 	if err != nil {
 		return "", err
 	}
@@ -41,6 +41,9 @@ func (asset *Asset) decodeTxInputs(mtx *wire.MsgTx,
 			if int(walletInput.Index) == i {
 				input.AccountNumber = int32(walletInput.PreviousAccount)
 				input.Amount = int64(walletInput.PreviousAmount)
+				if walletInput.PreviousAccount != DefaultAccountNum {
+					input.AccountNumber = asset.prevOutAccountNumber(&txIn.PreviousOutPoint, input.AccountNumber)
+				}
 				break
 			}
 		}
@@ -84,6 +87,13 @@ func (asset *Asset) decodeTxOutputs(mtx *wire.MsgTx,
 			if int32(walletOutput.Index) == output.Index {
 				output.Internal = walletOutput.Internal
 				output.AccountNumber = int32(walletOutput.Account)
+				if walletOutput.Account != DefaultAccountNum {
+					// The account number doesn't tell which key scope
+					// the output belongs to, the address does.
+					if accountNumber := asset.pkScriptAccountNumber(txOut.PkScript); accountNumber != -1 {
+						output.AccountNumber = accountNumber
+					}
+				}
 				break
 			}
 		}
@@ -97,3 +107,19 @@ func (asset *Asset) decodeTxOutputs(mtx *wire.MsgTx,
 
 	return
 }
+
+// prevOutAccountNumber returns the account number of the wallet output spent
+// by prevOut, or accountNumber if it can't be looked up.
+func (asset *Asset) prevOutAccountNumber(prevOut *wire.OutPoint, accountNumber int32) int32 {
+	// FetchInputInfo needs a chain client, which may not be running while
+	// transactions are decoded, so the tx store is queried directly.
+	details, err := wallet.UnstableAPI(asset.Internal().BTC).TxDetails(&prevOut.Hash)
+	if err != nil || details == nil || int(prevOut.Index) >= len(details.MsgTx.TxOut) {
+		return accountNumber
+	}
+	pkScript := details.MsgTx.TxOut[prevOut.Index].PkScript
+	if scopedNumber := asset.pkScriptAccountNumber(pkScript); scopedNumber != -1 {
+		return scopedNumber
+	}
+	return accountNumber
+}
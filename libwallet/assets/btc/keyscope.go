@@ -0,0 +1,250 @@
+package btc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet/txsizes"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// supportedKeyScopes lists the key scopes accounts can be created in. btcwallet
+// sets all of them up when a wallet is created from a seed and scans the
+// default account of each during restore discovery, so funds received on any
+// of these derivation paths by other wallets using the same seed are found.
+// The first entry is the default scope.
+var supportedKeyScopes = []waddrmgr.KeyScope{
+	waddrmgr.KeyScopeBIP0084,     // Native segwit (P2WPKH).
+	waddrmgr.KeyScopeBIP0049Plus, // Nested segwit (P2SH-P2WPKH).
+	waddrmgr.KeyScopeBIP0086,     // Taproot (P2TR).
+}
+
+// scopedAccountRange is the range of account numbers reserved for each
+// supported key scope. btcwallet numbers the accounts of every scope from
+// zero, so accounts outside the default scope are offset by their scope's
+// index times this range to keep account numbers unique within the wallet.
+// Accounts in the default scope keep btcwallet's numbering, which is what
+// wallets created before other scopes were supported use.
+const scopedAccountRange = 1 << 20
+
+// SupportedKeyScopes returns the key scopes new accounts can be created in.
+// The first scope is the one used by default.
+func SupportedKeyScopes() []sharedW.KeyScope {
+	scopes := make([]sharedW.KeyScope, len(supportedKeyScopes))
+	for i, scope := range supportedKeyScopes {
+		scopes[i] = sharedW.KeyScope{Purpose: scope.Purpose, Coin: scope.Coin}
+	}
+	return scopes
+}
+
+// activeKeyScopes returns the supported key scopes set up in this wallet.
+// Watch-only wallets only have the default scope.
+func (asset *Asset) activeKeyScopes() []waddrmgr.KeyScope {
+	scopes := make([]waddrmgr.KeyScope, 0, len(supportedKeyScopes))
+	for _, scope := range supportedKeyScopes {
+		if _, err := asset.Internal().BTC.Manager.FetchScopedKeyManager(scope); err == nil {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// toKeyScope returns the btcwallet key scope matching scope, or an error if
+// it isn't supported.
+func toKeyScope(scope sharedW.KeyScope) (waddrmgr.KeyScope, error) {
+	for _, supported := range supportedKeyScopes {
+		if supported.Purpose == scope.Purpose && supported.Coin == scope.Coin {
+			return supported, nil
+		}
+	}
+	return waddrmgr.KeyScope{}, errors.E(errors.Invalid,
+		fmt.Sprintf("unsupported key scope m/%d'/%d'", scope.Purpose, scope.Coin))
+}
+
+// accountScope splits an account number as used by this package into the key
+// scope the account belongs to and its number within that scope.
+func accountScope(accountNumber int32) (waddrmgr.KeyScope, uint32) {
+	index := int(accountNumber / scopedAccountRange)
+	if accountNumber < 0 || index >= len(supportedKeyScopes) {
+		// The imported account lives outside of any scope.
+		return GetScope(), uint32(accountNumber)
+	}
+	return supportedKeyScopes[index], uint32(accountNumber % scopedAccountRange)
+}
+
+// scopedAccountNumber is the inverse of accountScope. The default account of
+// every scope maps to DefaultAccountNum: btcwallet attributes funds by
+// account name and number, which the default accounts all share, so they're
+// presented as a single account spanning all supported scopes.
+func scopedAccountNumber(scope waddrmgr.KeyScope, account uint32) int32 {
+	if account == DefaultAccountNum || account == ImportedAccountNumber {
+		return int32(account)
+	}
+	for i, supported := range supportedKeyScopes {
+		if supported == scope {
+			return int32(i*scopedAccountRange) + int32(account)
+		}
+	}
+	return int32(account)
+}
+
+// addressAccountNumber returns the account number of the wallet address addr.
+func (asset *Asset) addressAccountNumber(addr btcutil.Address) (int32, error) {
+	managedAddr, err := asset.Internal().BTC.AddressInfo(addr)
+	if err != nil {
+		return -1, err
+	}
+	pubKeyAddr, ok := managedAddr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return int32(managedAddr.InternalAccount()), nil
+	}
+	scope, path, ok := pubKeyAddr.DerivationInfo()
+	if !ok {
+		return int32(managedAddr.InternalAccount()), nil
+	}
+	return scopedAccountNumber(scope, path.InternalAccount), nil
+}
+
+// pkScriptAccountNumber returns the account number of the wallet address
+// pkScript pays to, or -1 if it doesn't pay to one.
+func (asset *Asset) pkScriptAccountNumber(pkScript []byte) int32 {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, asset.chainParams)
+	if err != nil || len(addrs) == 0 {
+		return -1
+	}
+	accountNumber, err := asset.addressAccountNumber(addrs[0])
+	if err != nil {
+		return -1
+	}
+	return accountNumber
+}
+
+// isScopedScript returns true if pkScript pays to a key derived by one of the
+// non-default key scopes of this wallet. btcwallet doesn't report such outputs
+// as spendable even though it can sign for them. Scripts of the same types
+// that pay to imported keys or scripts, or that this wallet can't sign for,
+// aren't scoped scripts.
+func (asset *Asset) isScopedScript(pkScript []byte) bool {
+	if !txscript.IsPayToScriptHash(pkScript) && !txscript.IsPayToTaproot(pkScript) {
+		return false
+	}
+	if asset.IsWatchingOnlyWallet() {
+		return false
+	}
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, asset.chainParams)
+	if err != nil || len(addrs) != 1 {
+		return false
+	}
+	managedAddr, err := asset.Internal().BTC.AddressInfo(addrs[0])
+	if err != nil || managedAddr.Imported() {
+		return false
+	}
+	pubKeyAddr, ok := managedAddr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return false
+	}
+	scope, _, ok := pubKeyAddr.DerivationInfo()
+	return ok && isManagedScope(scope)
+}
+
+// isManagedScope returns true if scope is one of the supported key scopes
+// other than the default one.
+func isManagedScope(scope waddrmgr.KeyScope) bool {
+	for _, supported := range supportedKeyScopes[1:] {
+		if supported == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// estimateVirtualSize returns the worst case virtual size of a transaction
+// spending outputs paying to prevScripts to txOuts, plus a change output with
+// a script of changeScriptSize bytes if it's non-zero.
+func estimateVirtualSize(prevScripts [][]byte, txOuts []*wire.TxOut, changeScriptSize int) int {
+	var p2pkh, p2tr, p2wpkh, nested int
+	for _, pkScript := range prevScripts {
+		switch {
+		case txscript.IsPayToTaproot(pkScript):
+			p2tr++
+		case txscript.IsPayToWitnessPubKeyHash(pkScript):
+			p2wpkh++
+		case txscript.IsPayToScriptHash(pkScript):
+			nested++
+		default:
+			p2pkh++
+		}
+	}
+	return txsizes.EstimateVirtualSize(p2pkh, p2tr, p2wpkh, nested, txOuts, changeScriptSize)
+}
+
+// changeScriptSize returns the size of the output scripts of the change
+// addresses of the accounts in scope.
+func (asset *Asset) changeScriptSize(scope waddrmgr.KeyScope) (int, error) {
+	manager, err := asset.Internal().BTC.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return 0, err
+	}
+	switch manager.AddrSchema().InternalAddrType {
+	case waddrmgr.PubKeyHash:
+		return txsizes.P2PKHPkScriptSize, nil
+	case waddrmgr.NestedWitnessPubKey:
+		return txsizes.NestedP2WPKHPkScriptSize, nil
+	case waddrmgr.TaprootPubKey:
+		return txsizes.P2TRPkScriptSize, nil
+	default:
+		return txsizes.P2WPKHPkScriptSize, nil
+	}
+}
+
+// hdVersion returns the extended public key version bytes for scope on the
+// network described by params, e.g. zpub for BIP-84 on mainnet. These match
+// the versions btcwallet encodes account public keys with.
+func hdVersion(scope waddrmgr.KeyScope, params *chaincfg.Params) ([]byte, error) {
+	var version waddrmgr.HDVersion
+	switch params.Name {
+	case chaincfg.MainNetParams.Name:
+		switch scope {
+		case waddrmgr.KeyScopeBIP0049Plus:
+			version = waddrmgr.HDVersionMainNetBIP0049
+		case waddrmgr.KeyScopeBIP0084:
+			version = waddrmgr.HDVersionMainNetBIP0084
+		default:
+			// BIP-86 doesn't define its own version bytes.
+			version = waddrmgr.HDVersionMainNetBIP0044
+		}
+	case chaincfg.TestNet3Params.Name:
+		switch scope {
+		case waddrmgr.KeyScopeBIP0049Plus:
+			version = waddrmgr.HDVersionTestNetBIP0049
+		case waddrmgr.KeyScopeBIP0084:
+			version = waddrmgr.HDVersionTestNetBIP0084
+		default:
+			version = waddrmgr.HDVersionTestNetBIP0044
+		}
+	case chaincfg.SimNetParams.Name:
+		// Simnet has no versions of its own for BIP-49 and BIP-84.
+		switch scope {
+		case waddrmgr.KeyScopeBIP0049Plus:
+			version = waddrmgr.HDVersionMainNetBIP0049
+		case waddrmgr.KeyScopeBIP0084:
+			version = waddrmgr.HDVersionMainNetBIP0084
+		default:
+			version = waddrmgr.HDVersionSimNetBIP0044
+		}
+	default:
+		return nil, utils.ErrInvalidNet
+	}
+
+	versionBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(versionBytes, uint32(version))
+	return versionBytes, nil
+}
@@ -0,0 +1,117 @@
+package btc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcwallet/waddrmgr"
+)
+
+func TestAccountScope(t *testing.T) {
+	tests := []struct {
+		name          string
+		accountNumber int32
+		scope         waddrmgr.KeyScope
+		account       uint32
+	}{{
+		name:          "default account",
+		accountNumber: DefaultAccountNum,
+		scope:         waddrmgr.KeyScopeBIP0084,
+		account:       DefaultAccountNum,
+	}, {
+		name:          "default scope account",
+		accountNumber: 5,
+		scope:         waddrmgr.KeyScopeBIP0084,
+		account:       5,
+	}, {
+		name:          "nested segwit account",
+		accountNumber: scopedAccountRange + 1,
+		scope:         waddrmgr.KeyScopeBIP0049Plus,
+		account:       1,
+	}, {
+		name:          "taproot account",
+		accountNumber: 2*scopedAccountRange + 3,
+		scope:         waddrmgr.KeyScopeBIP0086,
+		account:       3,
+	}, {
+		name:          "imported account",
+		accountNumber: ImportedAccountNumber,
+		scope:         GetScope(),
+		account:       ImportedAccountNumber,
+	}, {
+		name:          "out of the supported scopes",
+		accountNumber: 3 * scopedAccountRange,
+		scope:         GetScope(),
+		account:       3 * scopedAccountRange,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scope, account := accountScope(test.accountNumber)
+			if scope != test.scope {
+				t.Fatalf("expected scope %v, got %v", test.scope, scope)
+			}
+			if account != test.account {
+				t.Fatalf("expected account %d, got %d", test.account, account)
+			}
+		})
+	}
+}
+
+func TestScopedAccountNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		scope         waddrmgr.KeyScope
+		account       uint32
+		accountNumber int32
+	}{{
+		name:          "default scope account",
+		scope:         waddrmgr.KeyScopeBIP0084,
+		account:       5,
+		accountNumber: 5,
+	}, {
+		name:          "nested segwit default account",
+		scope:         waddrmgr.KeyScopeBIP0049Plus,
+		account:       DefaultAccountNum,
+		accountNumber: DefaultAccountNum,
+	}, {
+		name:          "nested segwit account",
+		scope:         waddrmgr.KeyScopeBIP0049Plus,
+		account:       2,
+		accountNumber: scopedAccountRange + 2,
+	}, {
+		name:          "taproot account",
+		scope:         waddrmgr.KeyScopeBIP0086,
+		account:       1,
+		accountNumber: 2*scopedAccountRange + 1,
+	}, {
+		name:          "imported account",
+		scope:         waddrmgr.KeyScopeBIP0086,
+		account:       ImportedAccountNumber,
+		accountNumber: ImportedAccountNumber,
+	}, {
+		name:          "unsupported scope",
+		scope:         waddrmgr.KeyScopeBIP0044,
+		account:       4,
+		accountNumber: 4,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accountNumber := scopedAccountNumber(test.scope, test.account)
+			if accountNumber != test.accountNumber {
+				t.Fatalf("expected account number %d, got %d", test.accountNumber, accountNumber)
+			}
+
+			// Accounts other than the shared default account map back
+			// to their scope.
+			if test.account == DefaultAccountNum || test.scope == waddrmgr.KeyScopeBIP0044 {
+				return
+			}
+			scope, account := accountScope(accountNumber)
+			if test.account != ImportedAccountNumber && scope != test.scope {
+				t.Fatalf("expected scope %v, got %v", test.scope, scope)
+			}
+			if account != test.account {
+				t.Fatalf("expected account %d, got %d", test.account, account)
+			}
+		})
+	}
+}
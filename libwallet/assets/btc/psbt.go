@@ -0,0 +1,153 @@
+package btc
+
+import (
+	"bytes"
+	"os"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// CreateUnsignedPSBT builds a BIP-174 partially signed bitcoin transaction
+// from the transaction authored with NewUnsignedTx and AddSendDestination.
+// Every input is decorated with the UTXO and BIP-32 derivation information an
+// external signer needs, so this works for watch-only wallets as well.
+func (asset *Asset) CreateUnsignedPSBT() (*psbt.Packet, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	asset.TxAuthoredInfo.mu.Lock()
+	defer asset.TxAuthoredInfo.mu.Unlock()
+
+	unsignedTx, err := asset.unsignedTransaction()
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+
+	// If the change output is the only one, no need to change position.
+	if unsignedTx.ChangeIndex > 0 {
+		unsignedTx.RandomizeChangePosition()
+	}
+
+	msgTx := unsignedTx.Tx.Copy()
+	// To discourage fee sniping, LockTime is explicitly set in the raw tx.
+	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
+
+	packet, err := psbt.NewFromUnsignedTx(msgTx)
+	if err != nil {
+		log.Errorf("creating psbt failed: %v", err)
+		return nil, err
+	}
+
+	if err = asset.Internal().BTC.DecorateInputs(packet, true); err != nil {
+		log.Errorf("decorating psbt inputs failed: %v", err)
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+// ExportUnsignedPSBT returns the base64 encoding of the PSBT built by
+// CreateUnsignedPSBT.
+func (asset *Asset) ExportUnsignedPSBT() (string, error) {
+	packet, err := asset.CreateUnsignedPSBT()
+	if err != nil {
+		return "", err
+	}
+	return packet.B64Encode()
+}
+
+// SaveUnsignedPSBT writes the binary serialization of the PSBT built by
+// CreateUnsignedPSBT to filePath.
+func (asset *Asset) SaveUnsignedPSBT(filePath string) error {
+	packet, err := asset.CreateUnsignedPSBT()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err = packet.Serialize(&buf); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, buf.Bytes(), 0600)
+}
+
+// DecodePSBT parses a PSBT provided either in its binary serialization or as
+// base64 text, e.g. as read from a file or pasted by the user.
+func DecodePSBT(data []byte) (*psbt.Packet, error) {
+	data = bytes.TrimSpace(data)
+	if packet, err := psbt.NewFromRawBytes(bytes.NewReader(data), false); err == nil {
+		return packet, nil
+	}
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(data), true)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	return packet, nil
+}
+
+// FinalizePSBT signs the inputs of the provided PSBT that belong to this
+// wallet and finalizes the packet. Inputs that were signed externally are
+// left untouched. Watch-only wallets can't sign, so for them the PSBT must
+// already carry the signatures for all inputs and privatePassphrase is
+// ignored.
+func (asset *Asset) FinalizePSBT(data []byte, privatePassphrase string) (*wire.MsgTx, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	packet, err := DecodePSBT(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !packet.IsComplete() {
+		if asset.IsWatchingOnlyWallet() {
+			err = psbt.MaybeFinalizeAll(packet)
+		} else {
+			err = asset.signPSBT(packet, privatePassphrase)
+		}
+		if err != nil {
+			log.Errorf("finalizing psbt failed: %v", err)
+			return nil, err
+		}
+	}
+
+	return psbt.Extract(packet)
+}
+
+// signPSBT unlocks the wallet and signs every input of packet it owns.
+func (asset *Asset) signPSBT(packet *psbt.Packet, privatePassphrase string) error {
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+
+	err := asset.Internal().BTC.Unlock([]byte(privatePassphrase), lock)
+	if err != nil {
+		log.Errorf("unlocking the wallet failed: %v", err)
+		return errors.New(utils.ErrInvalidPassphrase)
+	}
+
+	// The account is only used to skip inputs of watch-only accounts, which
+	// a wallet holding private keys doesn't have.
+	scope := GetScope()
+	return asset.Internal().BTC.FinalizePsbt(&scope, 0, packet)
+}
+
+// BroadcastPSBT finalizes the provided PSBT as described in FinalizePSBT and
+// publishes the resulting transaction to the network.
+func (asset *Asset) BroadcastPSBT(data []byte, privatePassphrase, transactionLabel string) (string, error) {
+	msgTx, err := asset.FinalizePSBT(data, privatePassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	err = asset.Internal().BTC.PublishTransaction(msgTx, transactionLabel)
+	txHash := msgTx.TxHash()
+	return txHash.String(), utils.TranslateError(err)
+}
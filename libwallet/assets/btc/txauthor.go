@@ -313,24 +313,56 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	// https://bitcoin.stackexchange.com/questions/48384/why-bitcoin-core-creates-time-locked-transactions-by-default
 	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
 
+	if err = asset.signTransaction(msgTx); err != nil {
+		return "", err
+	}
+
+	var serializedTransaction bytes.Buffer
+	serializedTransaction.Grow(msgTx.SerializeSize())
+	err = msgTx.Serialize(&serializedTransaction)
+	if err != nil {
+		log.Errorf("encoding the tx to test its validity failed: %v", err)
+		return "", err
+	}
+
+	err = msgTx.Deserialize(bytes.NewReader(serializedTransaction.Bytes()))
+	if err != nil {
+		// Invalid tx
+		log.Errorf("decoding the tx to test its validity failed: %v", err)
+		return "", err
+	}
+
+	err = asset.Internal().BTC.PublishTransaction(msgTx, transactionLabel)
+	txHash := msgTx.TxHash()
+	return txHash.String(), utils.TranslateError(err)
+}
+
+// signTransaction signs every input of msgTx, all of which must spend outputs
+// controlled by the wallet, and validates the resulting scripts. The wallet
+// must be unlocked.
+func (asset *Asset) signTransaction(msgTx *wire.MsgTx) error {
+	// Taproot signatures commit to all the outputs being spent, so they
+	// must all be known before any input is signed.
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	previousTXouts := make([]*wire.TxOut, len(msgTx.TxIn))
 	for index, txIn := range msgTx.TxIn {
 		_, previousTXout, _, _, err := asset.Internal().BTC.FetchInputInfo(&txIn.PreviousOutPoint)
 		if err != nil {
 			log.Errorf("fetch previous outpoint txout failed: %v", err)
-			return "", err
+			return err
 		}
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, previousTXout)
+		previousTXouts[index] = previousTXout
+	}
+	sigHashes := txscript.NewTxSigHashes(msgTx, prevOutFetcher)
 
-		prevOutScript := unsignedTx.PrevScripts[index]
-		prevOutAmount := int64(asset.TxAuthoredInfo.inputValues[index])
-		prevOutFetcher := txscript.NewCannedPrevOutputFetcher(prevOutScript, prevOutAmount)
-		sigHashes := txscript.NewTxSigHashes(msgTx, prevOutFetcher)
-
+	for index, previousTXout := range previousTXouts {
 		witness, signature, err := asset.Internal().BTC.ComputeInputScript(
 			msgTx, previousTXout, index, sigHashes, txscript.SigHashAll, nil,
 		)
 		if err != nil {
 			log.Errorf("generating input signatures failed: %v", err)
-			return "", err
+			return err
 		}
 
 		msgTx.TxIn[index].Witness = witness
@@ -338,38 +370,19 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 
 		// Prove that the transaction has been validly signed by executing the
 		// script pair.
-		flags := txscript.ScriptBip16 | txscript.ScriptVerifyDERSignatures |
-			txscript.ScriptStrictMultiSig | txscript.ScriptDiscourageUpgradableNops
-		vm, err := txscript.NewEngine(previousTXout.PkScript, msgTx, 0, flags, nil, nil,
-			prevOutAmount, prevOutFetcher)
+		vm, err := txscript.NewEngine(previousTXout.PkScript, msgTx, index,
+			txscript.StandardVerifyFlags, nil, sigHashes,
+			previousTXout.Value, prevOutFetcher)
 		if err != nil {
 			log.Errorf("creating validation engine failed: %v", err)
-			return "", err
+			return err
 		}
 		if err := vm.Execute(); err != nil {
 			log.Errorf("executing the validation engine failed: %v", err)
-			return "", err
+			return err
 		}
 	}
-
-	var serializedTransaction bytes.Buffer
-	serializedTransaction.Grow(msgTx.SerializeSize())
-	err = msgTx.Serialize(&serializedTransaction)
-	if err != nil {
-		log.Errorf("encoding the tx to test its validity failed: %v", err)
-		return "", err
-	}
-
-	err = msgTx.Deserialize(bytes.NewReader(serializedTransaction.Bytes()))
-	if err != nil {
-		// Invalid tx
-		log.Errorf("decoding the tx to test its validity failed: %v", err)
-		return "", err
-	}
-
-	err = asset.Internal().BTC.PublishTransaction(msgTx, transactionLabel)
-	txHash := msgTx.TxHash()
-	return txHash.String(), utils.TranslateError(err)
+	return nil
 }
 
 func (asset *Asset) unsignedTransaction() (*txauthor.AuthoredTx, error) {
@@ -449,6 +462,8 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Frozen outputs are only spent when selected manually.
+		unspents = sharedW.UnfrozenOutputs(unspents)
 	}
 
 	inputSource := asset.makeInputSource(unspents, sendMax)
@@ -477,8 +492,8 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 // change source for receiving change from this tx back into the sharedW.
 func (asset *Asset) changeSource() (*txauthor.ChangeSource, error) {
 	if asset.TxAuthoredInfo.changeAddress == "" {
-		changeAccount := asset.TxAuthoredInfo.sourceAccountNumber
-		address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, GetScope())
+		scope, changeAccount := accountScope(int32(asset.TxAuthoredInfo.sourceAccountNumber))
+		address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, scope)
 		if err != nil {
 			return nil, fmt.Errorf("change address error: %v", err)
 		}
@@ -563,7 +578,10 @@ func (asset *Asset) makeInputSource(outputs []*sharedW.UnspentOutput, sendMax bo
 		totalInputValue += btcutil.Amount(output.Amount.(Amount))
 		pkScripts = append(pkScripts, script)
 		inputValues = append(inputValues, btcutil.Amount(output.Amount.(Amount)))
-		inputs = append(inputs, wire.NewTxIn(previousOutPoint, nil, nil))
+		txIn := wire.NewTxIn(previousOutPoint, nil, nil)
+		// Signal BIP-125 replaceability so the fee can be bumped later.
+		txIn.Sequence = rbfSequence
+		inputs = append(inputs, txIn)
 	}
 
 	if sourceErr == nil && totalInputValue == 0 {
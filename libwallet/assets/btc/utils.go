@@ -1,7 +1,6 @@
 package btc
 
 import (
-	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -88,8 +87,9 @@ func (asset *Asset) DeriveAccountXpub(seedMnemonic string, wordSeedType sharedW.
 	}
 	defer masterNode.Zero()
 
-	path := []uint32{hardenedKey(GetScope().Purpose), hardenedKey(GetScope().Coin)}
-	path = append(path, hardenedKey(account))
+	scope, scopeAccount := accountScope(int32(account))
+	path := []uint32{hardenedKey(scope.Purpose), hardenedKey(scope.Coin)}
+	path = append(path, hardenedKey(scopeAccount))
 
 	currentKey := masterNode
 	for _, pathPart := range path {
@@ -99,25 +99,9 @@ func (asset *Asset) DeriveAccountXpub(seedMnemonic string, wordSeedType sharedW.
 		}
 	}
 
-	pubVersionBytes := make([]byte, len(params.HDPublicKeyID))
-	copy(pubVersionBytes, params.HDPublicKeyID[:])
-
-	switch params.Name {
-	case chaincfg.TestNet3Params.Name:
-		binary.BigEndian.PutUint32(pubVersionBytes, uint32(
-			waddrmgr.HDVersionTestNetBIP0084,
-		))
-
-	case chaincfg.MainNetParams.Name:
-		binary.BigEndian.PutUint32(pubVersionBytes, uint32(
-			waddrmgr.HDVersionMainNetBIP0084,
-		))
-	case chaincfg.SimNetParams.Name:
-		binary.BigEndian.PutUint32(pubVersionBytes, uint32(
-			waddrmgr.HDVersionSimNetBIP0044,
-		))
-	default:
-		return "", utils.ErrInvalidNet
+	pubVersionBytes, err := hdVersion(scope, params)
+	if err != nil {
+		return "", err
 	}
 
 	currentKey, err = currentKey.CloneWithVersion(
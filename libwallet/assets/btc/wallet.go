@@ -480,15 +480,16 @@ func (asset *Asset) SetSpecificPeer(addresses string) {
 }
 
 // GetExtendedPubKey returns the extended public key of the given account,
-// to do that it calls btcwallet's AccountProperties method, using KeyScopeBIP0084
-// and the account number. On failure it returns error.
+// to do that it calls btcwallet's AccountProperties method, using the
+// account's key scope and number. On failure it returns error.
 func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 	loadedAsset := asset.Internal().BTC
 	if loadedAsset == nil {
 		return "", utils.ErrBTCNotInitialized
 	}
 
-	extendedPublicKey, err := loadedAsset.AccountProperties(GetScope(), uint32(account))
+	scope, scopeAccount := accountScope(account)
+	extendedPublicKey, err := loadedAsset.AccountProperties(scope, scopeAccount)
 	if err != nil {
 		return "", err
 	}
@@ -498,7 +499,8 @@ func (asset *Asset) GetExtendedPubKey(account int32) (string, error) {
 // AccountXPubMatches checks if the xpub of the provided account matches the
 // provided xpub.
 func (asset *Asset) AccountXPubMatches(account uint32, xPub string) (bool, error) {
-	acctXPubKey, err := asset.Internal().BTC.AccountProperties(GetScope(), account)
+	scope, scopeAccount := accountScope(int32(account))
+	acctXPubKey, err := asset.Internal().BTC.AccountProperties(scope, scopeAccount)
 	if err != nil {
 		return false, err
 	}
@@ -182,7 +182,7 @@ func (asset *Asset) StartAccountMixer(walletPassphrase string) error {
 
 		ctx, cancel := asset.ShutdownContextWithCancel()
 		asset.cancelAccountMixer = cancel
-		err = tb.Run(ctx, []byte(walletPassphrase))
+		err = asset.runAccountMixer(ctx, tb, walletPassphrase)
 		if err != nil {
 			log.Errorf("AccountMixer instance errored: %v", err)
 		}
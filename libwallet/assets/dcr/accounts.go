@@ -178,7 +178,7 @@ func (asset *Asset) SpendableForAccount(account int32) (int64, error) {
 
 // UnspentOutputs returns unspent outputs that can be used for transactions.
 // Unspent outputs that are locked by the wallet are not returned as valid
-// unspent utxos.
+// unspent utxos, unless they are locked because they're frozen.
 func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, error) {
 	if !asset.WalletOpened() {
 		return nil, utils.ErrDCRNotInitialized
@@ -198,7 +198,8 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 	unspentOutputs := make([]*sharedW.UnspentOutput, 0, len(unspents))
 	for _, utxo := range unspents {
 		hash := utxo.OutPoint.Hash
-		if asset.Internal().DCR.LockedOutpoint(&hash, utxo.OutPoint.Index) {
+		if asset.Internal().DCR.LockedOutpoint(&hash, utxo.OutPoint.Index) &&
+			!asset.IsOutputFrozen(hash.String(), utxo.OutPoint.Index) {
 			continue // utxo is locked.
 		}
 
@@ -228,6 +229,10 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 		})
 	}
 
+	if err := asset.MarkFrozenOutputs(unspentOutputs); err != nil {
+		return nil, err
+	}
+
 	return unspentOutputs, nil
 }
 
@@ -173,8 +173,13 @@ func (asset *Asset) SetVoteChoice(account int32, agendaID, choiceID, hash, passp
 		}
 
 		vspTicketInfo, err := vspTicket.VSPTicketInfo(ctx)
-		if err != nil && firstErr == nil {
-			if err.Error() != utils.ErrWalletLocked {
+		if errors.Is(err, errors.NotExist) {
+			// Solo ticket, voted by this wallet with the choice saved
+			// above; there is no VSP to update.
+			continue // try next tHash
+		}
+		if err != nil {
+			if firstErr == nil && err.Error() != utils.ErrWalletLocked {
 				// Ignore the wallet is locked error.
 				firstErr = err
 			}
@@ -183,13 +188,17 @@ func (asset *Asset) SetVoteChoice(account int32, agendaID, choiceID, hash, passp
 
 		// Update the vote choice for the ticket with the associated VSP.
 		vspClient, err := asset.VSPClient(account, vspTicketInfo.Host, vspTicketInfo.PubKey)
-		if err != nil && firstErr == nil {
-			firstErr = err
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
 			continue // try next tHash
 		}
 		err = vspClient.SetVoteChoice(ctx, vspTicket, newChoice, nil, nil)
-		if err != nil && firstErr == nil {
-			firstErr = err
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
 			continue // try next tHash
 		}
 	}
@@ -432,8 +432,15 @@ func (dw *DEXWallet) GetBlock(ctx context.Context, blockHash *chainhash.Hash) (*
 		return nil, errors.New("invalid sync state")
 	}
 
+	// The network backend is either the SPV syncer or, in solo voting mode,
+	// the dcrd RPC syncer.
+	n, err := dw.w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: Use a block cache.
-	blocks, err := dw.syncData.activeSyncData.syncer.Blocks(ctx, []*chainhash.Hash{blockHash})
+	blocks, err := n.Blocks(ctx, []*chainhash.Hash{blockHash})
 	if err != nil {
 		return nil, err
 	}
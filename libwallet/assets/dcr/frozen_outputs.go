@@ -0,0 +1,88 @@
+package dcr
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// OpenWallet opens the wallet and locks its frozen outputs, see FreezeOutput.
+func (asset *Asset) OpenWallet() error {
+	if err := asset.Wallet.OpenWallet(); err != nil {
+		return err
+	}
+
+	err := asset.Wallet.FrozenOutPoints(func(txHash string, index uint32) {
+		if err := asset.lockOutpoint(txHash, index, true); err != nil {
+			log.Errorf("[%d] Error locking frozen output %s:%d: %v", asset.ID, txHash, index, err)
+		}
+	})
+	if err != nil {
+		log.Errorf("[%d] Error reading frozen outputs: %v", asset.ID, err)
+	}
+	return nil
+}
+
+// FreezeOutput excludes the unspent output txHash:index from automatic coin
+// selection. Besides the send page's coin selection, the output is locked in
+// dcrwallet so the ticket buyer, the account mixer and VSP fee payments, which
+// select coins inside dcrwallet, don't spend it either. The lock is restored
+// every time the wallet is opened.
+func (asset *Asset) FreezeOutput(txHash string, index uint32) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+	if err := asset.lockOutpoint(txHash, index, true); err != nil {
+		return err
+	}
+	return asset.Wallet.FreezeOutput(txHash, index)
+}
+
+// UnfreezeOutput makes the unspent output txHash:index available to automatic
+// coin selection again.
+func (asset *Asset) UnfreezeOutput(txHash string, index uint32) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+	if err := asset.lockOutpoint(txHash, index, false); err != nil {
+		return err
+	}
+	return asset.Wallet.UnfreezeOutput(txHash, index)
+}
+
+func (asset *Asset) lockOutpoint(txHash string, index uint32, lock bool) error {
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return err
+	}
+	if lock {
+		asset.Internal().DCR.LockOutpoint(hash, index)
+	} else {
+		asset.Internal().DCR.UnlockOutpoint(hash, index)
+	}
+	return nil
+}
+
+// unfreezeSpentOutputs unfreezes the frozen outputs spent by tx, which were
+// selected manually, so their dcrwallet locks don't linger.
+func (asset *Asset) unfreezeSpentOutputs(tx *wire.MsgTx) {
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		if !asset.IsOutputFrozen(prevOut.Hash.String(), prevOut.Index) {
+			continue
+		}
+		if err := asset.UnfreezeOutput(prevOut.Hash.String(), prevOut.Index); err != nil {
+			log.Errorf("[%d] Error unfreezing spent output %v: %v", asset.ID, prevOut, err)
+		}
+	}
+}
+
+// pruneFrozenOutputs removes the outputs spent since they were frozen from the
+// frozen outputs. It must only be called once the wallet is synced.
+func (asset *Asset) pruneFrozenOutputs() {
+	err := asset.PruneFrozenOutputs(asset.GetAccountsRaw, asset.UnspentOutputs, asset.UnfreezeOutput)
+	if err != nil {
+		log.Errorf("[%d] Error pruning frozen outputs: %v", asset.ID, err)
+	}
+}
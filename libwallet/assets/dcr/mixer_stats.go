@@ -0,0 +1,180 @@
+package dcr
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"decred.org/dcrwallet/v4/ticketbuyer"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+const (
+	// maxMixerStatsDays is the number of days with mixes kept in MixerStats.
+	maxMixerStatsDays = 30
+
+	// mixingWindowCheckInterval is how often the account mixer checks if its
+	// mixing windows opened or closed.
+	mixingWindowCheckInterval = time.Minute
+)
+
+// MixerStats returns statistics about the wallet's mixes, computed from its
+// mixed transactions.
+func (asset *Asset) MixerStats() (*MixerStats, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	txs, err := asset.GetTransactionsRaw(0, 0, TxFilterMixed, true, "")
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &MixerStats{Denominations: make(map[int64]int)}
+	var firstMix time.Time
+	for _, tx := range txs {
+		mixedAmount := tx.MixDenomination * int64(tx.MixCount)
+		stats.Mixes++
+		stats.MixedOutputs += int(tx.MixCount)
+		stats.MixedAmount += mixedAmount
+		stats.FeesPaid += tx.Fee
+		if tx.MixCount > 0 {
+			stats.Denominations[tx.MixDenomination] += int(tx.MixCount)
+		}
+
+		timestamp := time.Unix(tx.Timestamp, 0)
+		firstMix = timestamp
+
+		day := periodStart(timestamp, StakingPeriodDay)
+		last := len(stats.Days) - 1
+		if last < 0 || !stats.Days[last].Day.Equal(day) {
+			if len(stats.Days) == maxMixerStatsDays {
+				continue
+			}
+			stats.Days = append(stats.Days, &MixerDayStats{Day: day})
+			last++
+		}
+		stats.Days[last].Mixes++
+		stats.Days[last].MixedOutputs += int(tx.MixCount)
+		stats.Days[last].MixedAmount += mixedAmount
+		stats.Days[last].FeesPaid += tx.Fee
+	}
+
+	if stats.Mixes > 0 {
+		days := time.Since(firstMix).Hours() / 24
+		if days < 1 {
+			days = 1
+		}
+		stats.MixesPerDay = float64(stats.Mixes) / days
+	}
+
+	mixed, err := asset.GetAccountBalance(asset.MixedAccountNumber())
+	if err != nil {
+		return stats, nil
+	}
+	unmixed, err := asset.GetAccountBalance(asset.UnmixedAccountNumber())
+	if err != nil {
+		return stats, nil
+	}
+	if total := mixed.Total.ToInt() + unmixed.Total.ToInt(); total > 0 {
+		stats.UnmixedShare = float64(unmixed.Total.ToInt()) / float64(total)
+	}
+
+	return stats, nil
+}
+
+// MixingWindows returns the times of day the account mixer is allowed to mix
+// in. The mixer mixes at any time if there are none.
+func (asset *Asset) MixingWindows() []MixingWindow {
+	var windows []MixingWindow
+	_ = asset.ReadUserConfigValue(sharedW.AccountMixerWindows, &windows)
+	return windows
+}
+
+// SetMixingWindows sets the times of day the account mixer is allowed to mix
+// in, or lets it mix at any time if windows is empty. They apply the next
+// time the mixer is started.
+func (asset *Asset) SetMixingWindows(windows []MixingWindow) error {
+	for _, window := range windows {
+		if window.FromHour < 0 || window.FromHour > 23 || window.ToHour < 0 || window.ToHour > 23 {
+			return errors.E(errors.Invalid, "mixing window hours must be between 0 and 23")
+		}
+	}
+	if windows == nil {
+		windows = []MixingWindow{}
+	}
+	asset.SaveUserConfigValue(sharedW.AccountMixerWindows, windows)
+	return nil
+}
+
+// IsWaitingForMixingWindow returns true if the account mixer is running but
+// outside of its mixing windows.
+func (asset *Asset) IsWaitingForMixingWindow() bool {
+	return asset.IsAccountMixerActive() && asset.mixerWaiting.Load()
+}
+
+func inMixingWindow(windows []MixingWindow, t time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+	for _, window := range windows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// runAccountMixer runs the mixer until ctx is canceled or it fails. If mixing
+// windows are set, it's only run while one of them is open.
+func (asset *Asset) runAccountMixer(ctx context.Context, tb *ticketbuyer.TB, walletPassphrase string) error {
+	windows := asset.MixingWindows()
+	if len(windows) == 0 {
+		return tb.Run(ctx, []byte(walletPassphrase))
+	}
+
+	defer asset.mixerWaiting.Store(false)
+	ticker := time.NewTicker(mixingWindowCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if !inMixingWindow(windows, time.Now()) {
+			asset.mixerWaiting.Store(true)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+				continue
+			}
+		}
+
+		asset.mixerWaiting.Store(false)
+		log.Infof("[%d] Mixing window opened", asset.ID)
+
+		windowCtx, cancel := context.WithCancel(ctx)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- tb.Run(windowCtx, []byte(walletPassphrase))
+		}()
+
+	wait:
+		for {
+			select {
+			case err := <-errc:
+				cancel()
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return err
+			case <-ticker.C:
+				if !inMixingWindow(windows, time.Now()) {
+					cancel()
+					<-errc
+					log.Infof("[%d] Mixing window closed", asset.ID)
+					break wait
+				}
+			}
+		}
+	}
+}
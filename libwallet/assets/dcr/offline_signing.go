@@ -0,0 +1,223 @@
+package dcr
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	w "decred.org/dcrwallet/v4/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// UnsignedTransaction is the format used to move a transaction authored by a
+// watch-only wallet to a seeded wallet for signing. Along with the raw
+// transaction it carries the previous output scripts of every input and the
+// BIP-44 path of the key that controls them, so the signing wallet needs
+// neither chain data nor a prior address discovery to sign it.
+type UnsignedTransaction struct {
+	Network string                `json:"network"`
+	Tx      string                `json:"tx"`
+	Inputs  []UnsignedTxInputInfo `json:"inputs"`
+}
+
+// UnsignedTxInputInfo holds the data needed to sign a single input of an
+// UnsignedTransaction.
+type UnsignedTxInputInfo struct {
+	PrevHash  string `json:"prevhash"`
+	PrevIndex uint32 `json:"previndex"`
+	PkScript  string `json:"pkscript"`
+	Amount    int64  `json:"amount"`
+	Account   uint32 `json:"account"`
+	Branch    uint32 `json:"branch"`
+	Child     uint32 `json:"child"`
+}
+
+// ExportUnsignedTransaction returns the JSON encoding of the transaction
+// authored with NewUnsignedTx and AddSendDestination, in the format expected
+// by SignExternalTransaction.
+func (asset *Asset) ExportUnsignedTransaction() ([]byte, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	unsignedTx, err := asset.unsignedTransaction()
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+
+	if unsignedTx.ChangeIndex >= 0 {
+		unsignedTx.RandomizeChangePosition()
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	inputs := make([]UnsignedTxInputInfo, len(unsignedTx.Tx.TxIn))
+	for i, txIn := range unsignedTx.Tx.TxIn {
+		pkScript := unsignedTx.PrevScripts[i]
+		_, addrs := stdscript.ExtractAddrs(0, pkScript, asset.chainParams)
+		if len(addrs) != 1 {
+			return nil, fmt.Errorf("unsupported script for input %d", i)
+		}
+
+		known, err := asset.Internal().DCR.KnownAddress(ctx, addrs[0])
+		if err != nil {
+			return nil, utils.TranslateError(err)
+		}
+		bip44Addr, ok := known.(w.BIP0044Address)
+		if !ok {
+			return nil, fmt.Errorf("input %d is not controlled by an HD account", i)
+		}
+
+		account, branch, child := bip44Addr.Path()
+		inputs[i] = UnsignedTxInputInfo{
+			PrevHash:  txIn.PreviousOutPoint.Hash.String(),
+			PrevIndex: txIn.PreviousOutPoint.Index,
+			PkScript:  hex.EncodeToString(pkScript),
+			Amount:    txIn.ValueIn,
+			Account:   account,
+			Branch:    branch,
+			Child:     child,
+		}
+	}
+
+	txBytes, err := unsignedTx.Tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&UnsignedTransaction{
+		Network: asset.chainParams.Name,
+		Tx:      hex.EncodeToString(txBytes),
+		Inputs:  inputs,
+	})
+}
+
+// SignExternalTransaction signs a transaction exported by a watch-only wallet
+// with ExportUnsignedTransaction and returns the hex encoded signed
+// transaction, ready for BroadcastSignedTransaction. It does not require the
+// signing wallet to be synced.
+func (asset *Asset) SignExternalTransaction(unsignedTxData []byte, privatePassphrase string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrDCRNotInitialized
+	}
+	if asset.IsWatchingOnlyWallet() {
+		return "", errors.New(utils.ErrWalletIsWatchOnly)
+	}
+
+	var unsignedTx UnsignedTransaction
+	if err := json.Unmarshal(unsignedTxData, &unsignedTx); err != nil {
+		return "", errors.E(errors.Invalid, err)
+	}
+	if unsignedTx.Network != asset.chainParams.Name {
+		return "", errors.E(errors.Invalid, fmt.Sprintf("transaction is for %s, wallet is on %s",
+			unsignedTx.Network, asset.chainParams.Name))
+	}
+
+	msgTx, err := decodeMsgTx(unsignedTx.Tx)
+	if err != nil {
+		return "", err
+	}
+	if len(unsignedTx.Inputs) != len(msgTx.TxIn) {
+		return "", errors.E(errors.Invalid, "input info does not match the transaction inputs")
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	prevScripts := make(map[wire.OutPoint][]byte, len(unsignedTx.Inputs))
+	for i, input := range unsignedTx.Inputs {
+		prevOut := msgTx.TxIn[i].PreviousOutPoint
+		if prevOut.Hash.String() != input.PrevHash || prevOut.Index != input.PrevIndex {
+			return "", errors.E(errors.Invalid, fmt.Sprintf("input %d does not match the transaction", i))
+		}
+
+		pkScript, err := hex.DecodeString(input.PkScript)
+		if err != nil {
+			return "", errors.E(errors.Invalid, err)
+		}
+		prevScripts[prevOut] = pkScript
+
+		// Make sure the signing wallet has derived the address so its
+		// private key can be looked up.
+		err = asset.Internal().DCR.SyncLastReturnedAddress(ctx, input.Account, input.Branch, input.Child)
+		if err != nil {
+			log.Errorf("deriving address for input %d failed: %v", i, err)
+			return "", err
+		}
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+
+	err = asset.Internal().DCR.Unlock(ctx, []byte(privatePassphrase), lock)
+	if err != nil {
+		log.Error(err)
+		return "", errors.New(utils.ErrInvalidPassphrase)
+	}
+
+	invalidSigs, err := asset.Internal().DCR.SignTransaction(ctx, msgTx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+	if len(invalidSigs) > 0 {
+		return "", fmt.Errorf("failed to sign input %d: %w", invalidSigs[0].InputIndex, invalidSigs[0].Error)
+	}
+
+	signedTx, err := msgTx.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(signedTx), nil
+}
+
+// BroadcastSignedTransaction publishes a transaction signed externally with
+// SignExternalTransaction. It is meant for watch-only wallets, which can't
+// sign the transactions they author.
+func (asset *Asset) BroadcastSignedTransaction(signedTxHex, transactionLabel string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrDCRNotInitialized
+	}
+
+	msgTx, err := decodeMsgTx(signedTxHex)
+	if err != nil {
+		return "", err
+	}
+	for i, txIn := range msgTx.TxIn {
+		if len(txIn.SignatureScript) == 0 {
+			return "", errors.E(errors.Invalid, fmt.Sprintf("input %d is not signed", i))
+		}
+	}
+
+	n, err := asset.Internal().DCR.NetworkBackend()
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	txHash, err := asset.Internal().DCR.PublishTransaction(ctx, msgTx, n)
+	if err != nil {
+		return "", utils.TranslateError(err)
+	}
+	asset.unfreezeSpentOutputs(msgTx)
+	return txHash.String(), asset.updateTxLabel(txHash, transactionLabel)
+}
+
+func decodeMsgTx(txHex string) (*wire.MsgTx, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+
+	msgTx := new(wire.MsgTx)
+	if err = msgTx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	return msgTx, nil
+}
@@ -0,0 +1,231 @@
+package dcr
+
+import (
+	"decred.org/dcrwallet/v4/chain"
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// dcrdRPCPort returns the default JSON-RPC port of dcrd on the wallet's
+// network.
+func (asset *Asset) dcrdRPCPort() string {
+	switch asset.NetType() {
+	case utils.Testnet:
+		return "19109"
+	case utils.Simulation, utils.DEXTest:
+		return "19556"
+	default:
+		return "9109"
+	}
+}
+
+// SoloVotingNode returns the dcrd node the wallet syncs with in solo voting
+// mode, or nil if solo voting isn't enabled. The node's RPC password is empty
+// until the ticket buyer decrypts it with the wallet's passphrase, see
+// unlockSoloVotingNode.
+func (asset *Asset) SoloVotingNode() *SoloVotingNode {
+	node := new(SoloVotingNode)
+	err := asset.ReadUserConfigValue(sharedW.SoloVotingNodeConfigKey, node)
+	if err != nil || node.Host == "" {
+		return nil
+	}
+	if len(node.EncryptedPass) > 0 {
+		asset.soloVotingPassMu.Lock()
+		node.Pass = asset.soloVotingPass
+		asset.soloVotingPassMu.Unlock()
+	}
+	return node
+}
+
+// isLocked returns true if the node's RPC password is saved encrypted and
+// hasn't been decrypted yet.
+func (node *SoloVotingNode) isLocked() bool {
+	return len(node.EncryptedPass) > 0 && node.Pass == ""
+}
+
+func (asset *Asset) setSoloVotingPass(pass string) {
+	asset.soloVotingPassMu.Lock()
+	asset.soloVotingPass = pass
+	asset.soloVotingPassMu.Unlock()
+}
+
+// unlockSoloVotingNode decrypts the RPC password of the solo voting node with
+// the wallet's passphrase. It returns true if the password was decrypted, in
+// which case the wallet must switch from SPV peers to the node.
+func (asset *Asset) unlockSoloVotingNode(passphrase string) bool {
+	node := asset.SoloVotingNode()
+	if node == nil || !node.isLocked() {
+		return false
+	}
+
+	pass, err := sharedW.DecryptWithPassphrase([]byte(passphrase), node.EncryptedPass)
+	if err != nil {
+		log.Errorf("[%d] Error decrypting the solo voting node password: %v", asset.ID, err)
+		return false
+	}
+	asset.setSoloVotingPass(pass)
+	return true
+}
+
+// UnspentSoloTickets returns the number of the wallet's unmined, immature and
+// live tickets that aren't registered with a VSP. Only the wallet itself can
+// vote them, which it stops doing when solo voting is disabled.
+func (asset *Asset) UnspentSoloTickets() (int, error) {
+	tickets, err := asset.UnspentUnexpiredTickets()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, ticket := range tickets {
+		if asset.TicketBuyerVSP(ticket.Hash) == "" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// IsSoloVotingEnabled returns true if the wallet votes its tickets itself
+// instead of relying on a VSP.
+func (asset *Asset) IsSoloVotingEnabled() bool {
+	return asset.SoloVotingNode() != nil
+}
+
+// LockWallet locks the wallet unless the ticket buyer is running in solo
+// voting mode. The ticket buyer keeps the wallet unlocked for the wallet to
+// vote its tickets, which passphrase checks and other operations that unlock
+// the wallet briefly must not undo.
+func (asset *Asset) LockWallet() {
+	if asset.IsAutoTicketsPurchaseActive() && asset.IsSoloVotingEnabled() {
+		return
+	}
+	asset.Wallet.LockWallet()
+}
+
+// SetSoloVotingNode enables solo voting with node, or disables it if node is
+// nil. In solo voting mode the wallet syncs with the dcrd node over JSON-RPC
+// instead of with SPV peers, tickets are bought without a VSP and the wallet
+// votes them itself when they are called. Votes can only be cast while the
+// wallet is synced and unlocked, that is while the ticket buyer runs. The sync
+// is restarted if it's running.
+//
+// The node's RPC password is saved encrypted with passphrase, the wallet's
+// spending passphrase, the same way the wallet seed is. After a restart the
+// wallet syncs with SPV peers until the ticket buyer, which is given the
+// passphrase, decrypts it. passphrase can only be checked here if the wallet
+// is locked.
+func (asset *Asset) SetSoloVotingNode(node *SoloVotingNode, passphrase string) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+
+	if node == nil {
+		node = new(SoloVotingNode)
+	} else if node.Host == "" {
+		return errors.E(errors.Invalid, "dcrd node address is required for solo voting")
+	}
+
+	saved := *node
+	saved.Pass, saved.EncryptedPass = "", nil
+	if node.Pass != "" {
+		if asset.IsLocked() {
+			if err := asset.UnlockWallet(passphrase); err != nil {
+				return utils.TranslateError(err)
+			}
+			asset.LockWallet()
+		}
+
+		encrypted, err := sharedW.EncryptWithPassphrase([]byte(passphrase), node.Pass)
+		if err != nil {
+			return err
+		}
+		saved.EncryptedPass = encrypted
+	}
+
+	asset.SaveUserConfigValue(sharedW.SoloVotingNodeConfigKey, &saved)
+	asset.setSoloVotingPass(node.Pass)
+
+	if asset.IsSyncing() || asset.IsSynced() {
+		return asset.RestartSpvSync()
+	}
+	return nil
+}
+
+// ChangePrivatePassphraseForWallet changes the wallet's passphrase and
+// re-encrypts the wallet seed and the solo voting node's RPC password with the
+// new passphrase.
+func (asset *Asset) ChangePrivatePassphraseForWallet(oldPrivatePassphrase, newPrivatePassphrase string, privatePassphraseType int32) error {
+	node := asset.SoloVotingNode()
+	if node == nil || len(node.EncryptedPass) == 0 {
+		return asset.Wallet.ChangePrivatePassphraseForWallet(oldPrivatePassphrase, newPrivatePassphrase, privatePassphraseType)
+	}
+
+	pass, err := sharedW.DecryptWithPassphrase([]byte(oldPrivatePassphrase), node.EncryptedPass)
+	if err != nil {
+		return errors.New(utils.ErrInvalidPassphrase)
+	}
+
+	err = asset.Wallet.ChangePrivatePassphraseForWallet(oldPrivatePassphrase, newPrivatePassphrase, privatePassphraseType)
+	if err != nil {
+		return err
+	}
+
+	node.Pass = ""
+	node.EncryptedPass, err = sharedW.EncryptWithPassphrase([]byte(newPrivatePassphrase), pass)
+	if err != nil {
+		// The node must be set up again.
+		log.Errorf("[%d] Error encrypting the solo voting node password: %v", asset.ID, err)
+		node.EncryptedPass = nil
+	}
+	asset.SaveUserConfigValue(sharedW.SoloVotingNodeConfigKey, node)
+	return nil
+}
+
+// rpcSyncer returns a syncer that syncs the wallet with node. Unlike the SPV
+// syncer it votes the wallet's winning tickets.
+func (asset *Asset) rpcSyncer(node *SoloVotingNode) *chain.Syncer {
+	syncer := chain.NewSyncer(asset.Internal().DCR, &chain.RPCOptions{
+		Address:     node.Host,
+		DefaultPort: asset.dcrdRPCPort(),
+		User:        node.User,
+		Pass:        node.Pass,
+		CA:          []byte(node.CACert),
+	})
+	syncer.SetCallbacks(asset.rpcSyncNotificationCallbacks())
+	return syncer
+}
+
+func (asset *Asset) rpcSyncNotificationCallbacks() *chain.Callbacks {
+	// The RPC syncer reports the number of headers added by each batch
+	// rather than the height of the last one, track the height here.
+	var headersStartHeight, headersFetched int32
+	return &chain.Callbacks{
+		Synced: func(synced bool) {
+			// The dcrd node is the wallet's only peer.
+			if synced {
+				asset.handlePeerCountUpdate(1)
+			} else {
+				asset.handlePeerCountUpdate(0)
+			}
+			asset.syncedWallet(synced)
+		},
+		FetchHeadersStarted: func() {
+			headersStartHeight, headersFetched = asset.GetBestBlockHeight(), 0
+			asset.fetchHeadersStarted()
+		},
+		FetchHeadersProgress: func(fetchedHeadersCount int32, lastHeaderTime int64) {
+			headersFetched += fetchedHeadersCount
+			asset.fetchHeadersProgress(headersStartHeight+headersFetched, lastHeaderTime)
+		},
+		FetchHeadersFinished:         asset.fetchHeadersFinished,
+		FetchMissingCFiltersStarted:  asset.fetchCFiltersStarted,
+		FetchMissingCFiltersProgress: asset.fetchCFiltersProgress,
+		FetchMissingCFiltersFinished: asset.fetchCFiltersEnded,
+		DiscoverAddressesStarted:     asset.discoverAddressesStarted,
+		DiscoverAddressesFinished:    asset.discoverAddressesFinished,
+		RescanStarted:                asset.rescanStarted,
+		RescanProgress:               asset.rescanProgress,
+		RescanFinished:               asset.rescanFinished,
+	}
+}
@@ -0,0 +1,242 @@
+package dcr
+
+import (
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// StakingPeriod is the length of the periods staking returns are grouped by.
+type StakingPeriod string
+
+const (
+	StakingPeriodDay   StakingPeriod = "day"
+	StakingPeriodWeek  StakingPeriod = "week"
+	StakingPeriodMonth StakingPeriod = "month"
+
+	daysPerYear = 365
+)
+
+// TicketReturn is the realized return of a voted or revoked ticket. Amounts
+// are in atoms.
+type TicketReturn struct {
+	TicketHash   string
+	SpenderHash  string
+	Revoked      bool
+	PurchaseTime time.Time
+	SpendTime    time.Time
+	// StakeAmount is the price the ticket was bought at.
+	StakeAmount int64
+	// Reward is what the ticket's vote or revocation returned on top of the
+	// funds spent on the ticket, ticket fee included. It's negative for
+	// revoked tickets.
+	Reward int64
+	// TicketFee is the transaction fee of the ticket purchase. It's already
+	// deducted from Reward.
+	TicketFee int64
+	// VSPFee is the total paid to the ticket's VSP, transaction fee included.
+	// Zero for solo tickets.
+	VSPFee int64
+	// FiatValue is the value of Reward-VSPFee at SpendTime, nil if no rate is
+	// available for that time.
+	FiatValue *float64
+}
+
+// NetReward returns the ticket's reward after the VSP fee.
+func (tr *TicketReturn) NetReward() int64 {
+	return tr.Reward - tr.VSPFee
+}
+
+// Days returns how long the ticket's funds were locked.
+func (tr *TicketReturn) Days() float64 {
+	return tr.SpendTime.Sub(tr.PurchaseTime).Hours() / 24
+}
+
+// AnnualizedReturn returns the ticket's net reward relative to its stake
+// amount, extrapolated to a year.
+func (tr *TicketReturn) AnnualizedReturn() float64 {
+	days := tr.Days()
+	if tr.StakeAmount <= 0 || days <= 0 {
+		return 0
+	}
+	return float64(tr.NetReward()) / float64(tr.StakeAmount) * daysPerYear / days
+}
+
+// StakingRewardsBucket sums up the returns of the tickets voted or revoked in
+// the period that starts at Start. Amounts are in atoms.
+type StakingRewardsBucket struct {
+	Start       time.Time
+	Votes       int
+	Revocations int
+	// VoteRewards and RevocationLosses are net of the ticket fees.
+	VoteRewards      int64
+	RevocationLosses int64
+	TicketFees       int64
+	VSPFees          int64
+	// FiatValue is the value of NetRewards at the time of each vote or
+	// revocation, nil unless a rate was available for all of them.
+	FiatValue *float64
+}
+
+// NetRewards returns the rewards of the bucket after revocation losses and
+// VSP fees.
+func (b *StakingRewardsBucket) NetRewards() int64 {
+	return b.VoteRewards - b.RevocationLosses - b.VSPFees
+}
+
+// StakingReturns returns the realized returns of the wallet's voted and
+// revoked tickets, oldest first. fiatRate returns the fiat rate of DCR at a
+// given time and may be nil; the fiat value of a ticket is left unset if it
+// returns an error.
+func (asset *Asset) StakingReturns(fiatRate func(at time.Time) (float64, error)) ([]*TicketReturn, error) {
+	voted, err := asset.GetTransactionsRaw(0, 0, TxFilterVoted, false, "")
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := asset.GetTransactionsRaw(0, 0, TxFilterRevoked, false, "")
+	if err != nil {
+		return nil, err
+	}
+
+	spenders := append(voted, revoked...)
+	returns := make([]*TicketReturn, 0, len(spenders))
+	for _, spender := range spenders {
+		if spender.TicketSpentHash == "" {
+			continue
+		}
+		ticket, err := asset.GetTransactionRaw(spender.TicketSpentHash)
+		if err != nil {
+			log.Warnf("[%d] Unable to read ticket %s: %v", asset.ID, spender.TicketSpentHash, err)
+			continue
+		}
+
+		tr := &TicketReturn{
+			TicketHash:   ticket.Hash,
+			SpenderHash:  spender.Hash,
+			Revoked:      spender.Type == TxTypeRevocation,
+			PurchaseTime: time.Unix(ticket.Timestamp, 0),
+			SpendTime:    time.Unix(spender.Timestamp, 0),
+			Reward:       spender.VoteReward,
+			TicketFee:    ticket.Fee,
+			VSPFee:       asset.ticketVSPFee(ticket.Hash),
+		}
+		// The stake amount is the value of the ticket's voting output.
+		for _, output := range ticket.Outputs {
+			if output.Index == 0 {
+				tr.StakeAmount = output.Amount
+				break
+			}
+		}
+
+		if fiatRate != nil {
+			if rate, err := fiatRate(tr.SpendTime); err == nil {
+				fiatValue := asset.ToAmount(tr.NetReward()).ToCoin() * rate
+				tr.FiatValue = &fiatValue
+			}
+		}
+
+		returns = append(returns, tr)
+	}
+
+	sort.Slice(returns, func(i, j int) bool {
+		return returns[i].SpendTime.Before(returns[j].SpendTime)
+	})
+	return returns, nil
+}
+
+// ticketVSPFee returns the amount paid for the fee of the VSP ticket with the
+// provided hash, or zero if the ticket has no VSP or the fee transaction
+// isn't known to the wallet.
+func (asset *Asset) ticketVSPFee(ticketHash string) int64 {
+	hash, err := chainhash.NewHashFromStr(ticketHash)
+	if err != nil {
+		return 0
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	ticket, err := asset.Internal().DCR.NewVSPTicket(ctx, hash)
+	if err != nil {
+		return 0
+	}
+	feeHash, err := ticket.FeeHash(ctx)
+	if err != nil {
+		return 0
+	}
+	feeTx, err := asset.GetTransactionRaw(feeHash.String())
+	if err != nil {
+		return 0
+	}
+	return feeTx.Amount + feeTx.Fee
+}
+
+// BucketStakingReturns groups returns by the period their tickets were voted
+// or revoked in, newest period first. Periods are in the local time zone and
+// weeks start on Monday.
+func BucketStakingReturns(returns []*TicketReturn, period StakingPeriod) []*StakingRewardsBucket {
+	buckets := make(map[time.Time]*StakingRewardsBucket)
+	for _, tr := range returns {
+		start := periodStart(tr.SpendTime, period)
+		bucket, ok := buckets[start]
+		if !ok {
+			bucket = &StakingRewardsBucket{Start: start, FiatValue: new(float64)}
+			buckets[start] = bucket
+		}
+
+		if tr.Revoked {
+			bucket.Revocations++
+			bucket.RevocationLosses -= tr.Reward
+		} else {
+			bucket.Votes++
+			bucket.VoteRewards += tr.Reward
+		}
+		bucket.TicketFees += tr.TicketFee
+		bucket.VSPFees += tr.VSPFee
+
+		if tr.FiatValue == nil || bucket.FiatValue == nil {
+			bucket.FiatValue = nil
+		} else {
+			*bucket.FiatValue += *tr.FiatValue
+		}
+	}
+
+	sorted := make([]*StakingRewardsBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		sorted = append(sorted, bucket)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.After(sorted[j].Start)
+	})
+	return sorted
+}
+
+func periodStart(t time.Time, period StakingPeriod) time.Time {
+	t = t.Local()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch period {
+	case StakingPeriodWeek:
+		// time.Sunday is 0, move it after Saturday.
+		weekday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -weekday)
+	case StakingPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+// AnnualizedStakingReturn returns the realized annualized return of returns as
+// a whole: their net rewards relative to the stake amounts weighted by how
+// long each was locked.
+func AnnualizedStakingReturn(returns []*TicketReturn) float64 {
+	var netRewards int64
+	var stakeDays float64
+	for _, tr := range returns {
+		netRewards += tr.NetReward()
+		stakeDays += float64(tr.StakeAmount) * tr.Days()
+	}
+	if stakeDays <= 0 {
+		return 0
+	}
+	return float64(netRewards) * daysPerYear / stakeDays
+}
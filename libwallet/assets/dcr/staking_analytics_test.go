@@ -0,0 +1,158 @@
+package dcr
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// testTicketReturn returns a ticket bought days before it was spent at spend.
+func testTicketReturn(spend time.Time, days int, revoked bool, reward int64, fiatValue *float64) *TicketReturn {
+	return &TicketReturn{
+		Revoked:      revoked,
+		PurchaseTime: spend.AddDate(0, 0, -days),
+		SpendTime:    spend,
+		StakeAmount:  100_000,
+		Reward:       reward,
+		TicketFee:    10,
+		VSPFee:       20,
+		FiatValue:    fiatValue,
+	}
+}
+
+func fiat(v float64) *float64 {
+	return &v
+}
+
+func TestBucketStakingReturns(t *testing.T) {
+	// Wednesday, Sunday and the Monday after it, then the next month.
+	wed := time.Date(2024, time.May, 15, 10, 0, 0, 0, time.Local)
+	sun := time.Date(2024, time.May, 19, 23, 0, 0, 0, time.Local)
+	mon := time.Date(2024, time.May, 20, 1, 0, 0, 0, time.Local)
+	june := time.Date(2024, time.June, 2, 12, 0, 0, 0, time.Local)
+	returns := []*TicketReturn{
+		testTicketReturn(wed, 10, false, 1000, fiat(1)),
+		testTicketReturn(wed, 10, true, -50, fiat(2)),
+		testTicketReturn(sun, 10, false, 2000, nil),
+		testTicketReturn(mon, 10, false, 3000, fiat(3)),
+		testTicketReturn(june, 10, false, 4000, fiat(4)),
+	}
+
+	type bucket struct {
+		start       time.Time
+		votes       int
+		revocations int
+		netRewards  int64
+		// fiatValue is -1 if the bucket has no fiat value.
+		fiatValue float64
+	}
+	tests := []struct {
+		name   string
+		period StakingPeriod
+		want   []bucket
+	}{
+		{
+			name:   "days",
+			period: StakingPeriodDay,
+			want: []bucket{
+				{start: time.Date(2024, time.June, 2, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 3980, fiatValue: 4},
+				{start: time.Date(2024, time.May, 20, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 2980, fiatValue: 3},
+				{start: time.Date(2024, time.May, 19, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 1980, fiatValue: -1},
+				{start: time.Date(2024, time.May, 15, 0, 0, 0, 0, time.Local), votes: 1, revocations: 1, netRewards: 910, fiatValue: 3},
+			},
+		},
+		{
+			name:   "weeks start on monday",
+			period: StakingPeriodWeek,
+			want: []bucket{
+				{start: time.Date(2024, time.May, 27, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 3980, fiatValue: 4},
+				{start: time.Date(2024, time.May, 20, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 2980, fiatValue: 3},
+				{start: time.Date(2024, time.May, 13, 0, 0, 0, 0, time.Local), votes: 2, revocations: 1, netRewards: 2890, fiatValue: -1},
+			},
+		},
+		{
+			name:   "months",
+			period: StakingPeriodMonth,
+			want: []bucket{
+				{start: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.Local), votes: 1, netRewards: 3980, fiatValue: 4},
+				{start: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.Local), votes: 3, revocations: 1, netRewards: 5870, fiatValue: -1},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buckets := BucketStakingReturns(returns, tc.period)
+			if len(buckets) != len(tc.want) {
+				t.Fatalf("expected %d buckets, got %d", len(tc.want), len(buckets))
+			}
+			for i, want := range tc.want {
+				got := buckets[i]
+				if !got.Start.Equal(want.start) {
+					t.Fatalf("expected bucket %d to start at %v, got %v", i, want.start, got.Start)
+				}
+				if got.Votes != want.votes || got.Revocations != want.revocations {
+					t.Fatalf("expected %d votes and %d revocations in bucket %d, got %d and %d",
+						want.votes, want.revocations, i, got.Votes, got.Revocations)
+				}
+				if got.NetRewards() != want.netRewards {
+					t.Fatalf("expected net rewards %d in bucket %d, got %d", want.netRewards, i, got.NetRewards())
+				}
+				fiatValue := -1.0
+				if got.FiatValue != nil {
+					fiatValue = *got.FiatValue
+				}
+				if fiatValue != want.fiatValue {
+					t.Fatalf("expected fiat value %v in bucket %d, got %v", want.fiatValue, i, fiatValue)
+				}
+			}
+		})
+	}
+}
+
+func TestAnnualizedStakingReturn(t *testing.T) {
+	spend := time.Date(2024, time.May, 15, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		returns []*TicketReturn
+		want    float64
+	}{
+		{
+			name: "no returns",
+			want: 0,
+		},
+		{
+			name:    "ticket locked for a year",
+			returns: []*TicketReturn{testTicketReturn(spend, 365, false, 10_020, nil)},
+			want:    0.1,
+		},
+		{
+			name:    "ticket locked for a tenth of a year",
+			returns: []*TicketReturn{testTicketReturn(spend, 73, false, 1020, nil)},
+			want:    0.05,
+		},
+		{
+			name: "weighted by lock time",
+			returns: []*TicketReturn{
+				testTicketReturn(spend, 73, false, 1020, nil),
+				testTicketReturn(spend, 292, false, 4020, nil),
+			},
+			// 5000 net rewards over 365 stake days of 100000 atoms.
+			want: 0.05,
+		},
+		{
+			name:    "revocation loss",
+			returns: []*TicketReturn{testTicketReturn(spend, 365, true, -980, nil)},
+			want:    -0.01,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := AnnualizedStakingReturn(tc.returns)
+			if math.Abs(got-tc.want) > 1e-9 {
+				t.Fatalf("expected annualized return %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
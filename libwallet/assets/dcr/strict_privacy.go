@@ -0,0 +1,138 @@
+package dcr
+
+import (
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// IsStrictPrivacySendEnabled returns true if transactions of the wallet are
+// constructed in strict privacy mode, see SetStrictPrivacySend.
+func (asset *Asset) IsStrictPrivacySendEnabled() bool {
+	return asset.ReadBoolConfigValueForKey(sharedW.StrictPrivacySendConfigKey, false)
+}
+
+// SetStrictPrivacySend enables or disables strict privacy mode for the
+// transactions of the wallet. In strict privacy mode a transaction never
+// combines outputs of the mixed and unmixed accounts, the change of a
+// transaction spending mixed outputs never goes to the unmixed account, and
+// destination addresses that were used before are reported by
+// ReusedDestinationAddresses. It requires the account mixer to be set up.
+func (asset *Asset) SetStrictPrivacySend(enabled bool) error {
+	if enabled && !asset.AccountMixerConfigIsSet() {
+		return errors.E(errors.Invalid, "strict privacy mode requires the mixer accounts to be set up")
+	}
+	asset.SetBoolConfigValueForKey(sharedW.StrictPrivacySendConfigKey, enabled)
+	return nil
+}
+
+// ReusedDestinationAddresses returns the destination addresses of the unsigned
+// transaction that were used by earlier transactions of the wallet. It's only
+// set in strict privacy mode, once the transaction is constructed.
+func (asset *Asset) ReusedDestinationAddresses() []string {
+	if asset.TxAuthoredInfo == nil {
+		return nil
+	}
+	return asset.TxAuthoredInfo.reusedAddresses
+}
+
+// addressAccount returns the number of the wallet account address belongs
+// to, or -1 if it isn't a wallet address.
+func (asset *Asset) addressAccount(ctx context.Context, address string) int32 {
+	addr, err := stdaddr.DecodeAddress(address, asset.chainParams)
+	if err != nil {
+		return -1
+	}
+	known, err := asset.Internal().DCR.KnownAddress(ctx, addr)
+	if err != nil {
+		return -1
+	}
+	accountNumber, err := asset.AccountNumber(known.AccountName())
+	if err != nil {
+		return -1
+	}
+	return accountNumber
+}
+
+// enforceStrictPrivacy fails if spending unspents with the change going to
+// changeAddress would link the mixed and unmixed accounts. It's a no-op if
+// the mixer accounts aren't set up.
+func (asset *Asset) enforceStrictPrivacy(ctx context.Context, unspents []*sharedW.UnspentOutput, changeAddress string) error {
+	mixedAccount, unmixedAccount := asset.MixedAccountNumber(), asset.UnmixedAccountNumber()
+	if mixedAccount == -1 || unmixedAccount == -1 {
+		return nil
+	}
+
+	var spendsMixed, spendsUnmixed bool
+	for _, unspent := range unspents {
+		switch asset.addressAccount(ctx, unspent.Address) {
+		case mixedAccount:
+			spendsMixed = true
+		case unmixedAccount:
+			spendsUnmixed = true
+		}
+	}
+	if spendsMixed && spendsUnmixed {
+		return errors.E(errors.Invalid, "strict privacy mode: outputs of the mixed and unmixed accounts can't be spent together")
+	}
+	if spendsMixed && asset.addressAccount(ctx, changeAddress) == unmixedAccount {
+		return errors.E(errors.Invalid, "strict privacy mode: the change of mixed outputs can't go to the unmixed account")
+	}
+	return nil
+}
+
+// reusedAddresses returns the addresses that earlier transactions of the
+// wallet sent to or received at.
+func (asset *Asset) reusedAddresses(addresses []string) ([]string, error) {
+	used, err := asset.usedAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	var reused []string
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		if used[address] && !seen[address] {
+			seen[address] = true
+			reused = append(reused, address)
+		}
+	}
+	sort.Strings(reused)
+	return reused, nil
+}
+
+// usedAddresses returns the set of output addresses of the wallet's
+// transactions. It's cached until the number of transactions changes.
+func (asset *Asset) usedAddresses() (map[string]bool, error) {
+	count, err := asset.CountTransactions(TxFilterAll)
+	if err != nil {
+		return nil, err
+	}
+
+	asset.usedAddressesMu.Lock()
+	defer asset.usedAddressesMu.Unlock()
+
+	if asset.usedAddressesCache != nil && asset.usedAddressesTxCount == count {
+		return asset.usedAddressesCache, nil
+	}
+
+	txs, err := asset.GetTransactionsRaw(0, 0, TxFilterAll, false, "")
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool)
+	for _, tx := range txs {
+		for _, output := range tx.Outputs {
+			if output.Address != "" {
+				used[output.Address] = true
+			}
+		}
+	}
+
+	asset.usedAddressesCache = used
+	asset.usedAddressesTxCount = count
+	return used, nil
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"decred.org/dcrwallet/v4/chain"
 	"decred.org/dcrwallet/v4/errors"
 	"decred.org/dcrwallet/v4/p2p"
 	"decred.org/dcrwallet/v4/spv"
@@ -78,9 +79,16 @@ func (s *SyncData) generalSyncProgress() *sharedW.GeneralSyncProgress {
 	return s.activeSyncData.genSyncProgress
 }
 
+// chainSyncer is implemented by the SPV syncer and by the dcrd JSON-RPC syncer
+// used in solo voting mode.
+type chainSyncer interface {
+	Run(ctx context.Context) error
+	Synced(ctx context.Context) (bool, int32)
+}
+
 // reading/writing of properties of this struct are protected by syncData.mu.
 type activeSyncData struct {
-	syncer    *spv.Syncer
+	syncer    chainSyncer
 	syncStage utils.SyncStage
 
 	addressDiscoveryCompletedOrCanceled chan bool
@@ -213,18 +221,29 @@ func (asset *Asset) SpvSync() error {
 	asset.waitingForHeaders = true
 	asset.syncing = true
 
-	addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
-	addrManager := addrmgr.New(asset.DataDir(), net.LookupIP) // TODO: be mindful of tor
-	lp := p2p.NewLocalPeer(asset.chainParams, addr, addrManager)
-
-	// Set the node to only connect to remote peers whose advertised best block
-	// height is greater than the currently synced.
-	lp.RequirePeerHeight(asset.GetBestBlockHeight())
-
-	syncer := spv.NewSyncer(asset.Internal().DCR, lp)
-	syncer.SetNotifications(asset.spvSyncNotificationCallbacks())
-	if len(validPeerAddresses) > 0 {
-		syncer.SetPersistentPeers(validPeerAddresses)
+	var syncer chainSyncer
+	if node := asset.SoloVotingNode(); node != nil && !node.isLocked() {
+		// SPV peers don't announce the winning tickets, solo voting wallets
+		// sync with their dcrd node instead.
+		syncer = asset.rpcSyncer(node)
+	} else {
+		if node != nil {
+			log.Infof("[%d] Syncing with SPV peers until the ticket buyer unlocks the solo voting node", asset.ID)
+		}
+		addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
+		addrManager := addrmgr.New(asset.DataDir(), net.LookupIP) // TODO: be mindful of tor
+		lp := p2p.NewLocalPeer(asset.chainParams, addr, addrManager)
+
+		// Set the node to only connect to remote peers whose advertised best block
+		// height is greater than the currently synced.
+		lp.RequirePeerHeight(asset.GetBestBlockHeight())
+
+		spvSyncer := spv.NewSyncer(asset.Internal().DCR, lp)
+		spvSyncer.SetNotifications(asset.spvSyncNotificationCallbacks())
+		if len(validPeerAddresses) > 0 {
+			spvSyncer.SetPersistentPeers(validPeerAddresses)
+		}
+		syncer = spvSyncer
 	}
 
 	ctx, cancel := asset.ShutdownContextWithCancel()
@@ -375,12 +394,51 @@ func (asset *Asset) SyncData() *SyncData {
 	return asset.syncData
 }
 
+// peersBestBlock returns the best block of the wallet's peers, or of the dcrd
+// node in solo voting mode, while syncing and the best synced block after.
+func (asset *Asset) peersBestBlock(ctx context.Context) int32 {
+	asset.syncData.mu.RLock()
+	var syncer chainSyncer
+	if asset.syncData.activeSyncData != nil {
+		syncer = asset.syncData.activeSyncData.syncer
+	}
+	asset.syncData.mu.RUnlock()
+
+	if syncer == nil {
+		return asset.GetBestBlockHeight()
+	}
+
+	// The RPC syncer only sets its target height after it notified that
+	// headers fetching started, ask the node directly until it's synced.
+	if nodeSyncer, ok := syncer.(*chain.Syncer); ok {
+		if synced, height := nodeSyncer.Synced(ctx); synced {
+			return height
+		}
+		if nodeSyncer.RPC() == nil {
+			return asset.GetBestBlockHeight()
+		}
+		info, err := nodeSyncer.RPC().GetBlockchainInfo(ctx)
+		if err != nil {
+			log.Errorf("[%d] Error reading the dcrd node's best block: %v", asset.ID, err)
+			return asset.GetBestBlockHeight()
+		}
+		return int32(info.Headers)
+	}
+
+	_, height := syncer.Synced(ctx)
+	return height
+}
+
 func (asset *Asset) PeerInfoRaw() ([]sharedW.PeerInfo, error) {
 	if !asset.IsConnectedToDecredNetwork() || asset.syncData.activeSyncData == nil {
 		return nil, errors.New(utils.ErrNotConnected)
 	}
 
-	syncer := asset.syncData.activeSyncData.syncer
+	syncer, ok := asset.syncData.activeSyncData.syncer.(*spv.Syncer)
+	if !ok {
+		// Synced with a solo voting node rather than SPV peers.
+		return []sharedW.PeerInfo{}, nil
+	}
 
 	infos := make([]sharedW.PeerInfo, 0, len(syncer.GetRemotePeers()))
 	for _, rp := range syncer.GetRemotePeers() {
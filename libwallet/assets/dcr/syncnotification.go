@@ -170,7 +170,7 @@ func (asset *Asset) fetchHeadersStarted() {
 	// Returns the best synced block if syncing is done or the best block from
 	// the connected peers if not connected.
 	ctx, _ := asset.ShutdownContextWithCancel()
-	_, peerInitialHeight := asset.syncData.activeSyncData.syncer.Synced(ctx)
+	peerInitialHeight := asset.peersBestBlock(ctx)
 
 	asset.syncData.mu.Lock()
 	asset.syncData.activeSyncData.syncStage = HeadersFetchSyncStage
@@ -211,7 +211,7 @@ func (asset *Asset) fetchHeadersProgress(lastFetchedHeaderHeight int32, _ int64)
 
 		// It returns the best synced block if syncing is done or the best block
 		// from the connected peers if not connected.
-		_, peersBestBlock = asset.syncData.activeSyncData.syncer.Synced(ctx)
+		peersBestBlock = asset.peersBestBlock(ctx)
 
 		if lastFetchedHeaderHeight <= peersBestBlock {
 			asset.syncData.mu.Lock()
@@ -635,6 +635,11 @@ func (asset *Asset) syncedWallet(synced bool) {
 				log.Errorf("Tx Index Error: %v", err)
 			}
 
+			if synced {
+				asset.startVSPFeeReconciler()
+				asset.pruneFrozenOutputs()
+			}
+
 			for _, syncProgressListener := range asset.syncProgressListeners() {
 				if synced {
 					if syncProgressListener.OnSyncCompleted != nil {
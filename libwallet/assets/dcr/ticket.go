@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"runtime/trace"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"decred.org/dcrwallet/v4/errors"
+	"decred.org/dcrwallet/v4/vsp"
 	w "decred.org/dcrwallet/v4/wallet"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
@@ -100,15 +102,29 @@ func (asset *Asset) TicketPrice() (*TicketPriceResponse, error) {
 }
 
 // PurchaseTickets purchases tickets from the asset.
-// Returns a slice of hashes for tickets purchased.
+// Returns a slice of hashes for tickets purchased. If vspHost is empty, the
+// tickets are bought for solo voting: no VSP fee is paid and the voting rights
+// stay with the wallet, which requires solo voting to be enabled. Such tickets
+// are only voted while the wallet is synced with the solo voting node and
+// unlocked, which only the ticket buyer keeps it: tickets bought manually
+// without a VSP miss their votes unless the ticket buyer runs when they are
+// called.
 func (asset *Asset) PurchaseTickets(account, numTickets int32, vspHost, passphrase string, vspPubKey []byte) ([]*chainhash.Hash, error) {
 	if !asset.WalletOpened() {
 		return nil, utils.ErrDCRNotInitialized
 	}
 
-	vspClient, err := asset.VSPClient(account, vspHost, vspPubKey)
-	if err != nil {
-		return nil, fmt.Errorf("VSP Server instance failed to start: %v", err)
+	var vspClient *vsp.Client
+	if vspHost != "" {
+		var err error
+		vspClient, err = asset.VSPClient(account, vspHost, vspPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("VSP Server instance failed to start: %v", err)
+		}
+	} else if !asset.IsSoloVotingEnabled() {
+		return nil, errors.E(errors.Invalid, "a VSP is required unless solo voting is enabled")
+	} else if !asset.IsAutoTicketsPurchaseActive() {
+		log.Warnf("[%d] Buying solo tickets while the ticket buyer is stopped, they will only be voted while it runs", asset.ID)
 	}
 
 	networkBackend, err := asset.Internal().DCR.NetworkBackend()
@@ -123,16 +139,18 @@ func (asset *Asset) PurchaseTickets(account, numTickets int32, vspHost, passphra
 	defer asset.LockWallet()
 
 	request := &w.PurchaseTicketsRequest{
-		Count:                int(numTickets),
-		SourceAccount:        uint32(account),
-		MinConf:              asset.RequiredConfirmations(),
-		VSPFeePercent:        vspClient.FeePercentage,
-		VSPFeePaymentProcess: vspClient.Process,
+		Count:         int(numTickets),
+		SourceAccount: uint32(account),
+		MinConf:       asset.RequiredConfirmations(),
 
 		// VotingAccount used to derive addresses for specifying voting rights.
 		// It is used when VotingAddress == nil, or Mixing == true
 		VotingAccount: uint32(account),
 	}
+	if vspClient != nil {
+		request.VSPFeePercent = vspClient.FeePercentage
+		request.VSPFeePaymentProcess = vspClient.Process
+	}
 
 	csppCfg := asset.readCSPPConfig()
 	if csppCfg == nil {
@@ -228,6 +246,12 @@ func (asset *Asset) VSPTicketInfo(hash string) (*VSPTicketInfo, error) {
 // should already be configured with the required parameters using
 // asset.SetAutoTicketsBuyerConfig().
 func (asset *Asset) StartTicketBuyer(passphrase string) error {
+	return asset.startTicketBuyer(passphrase, TicketBuyerStartedByUser)
+}
+
+// startTicketBuyer starts the ticket buyer and records the start of a new
+// session with reason in the ticket buyer history.
+func (asset *Asset) startTicketBuyer(passphrase, reason string) error {
 	if !asset.WalletOpened() {
 		return utils.ErrDCRNotInitialized
 	}
@@ -239,7 +263,8 @@ func (asset *Asset) StartTicketBuyer(passphrase string) error {
 	}
 
 	cfg := asset.AutoTicketsBuyerConfig()
-	if cfg.VspHost == "" {
+	soloVoting := asset.IsSoloVotingEnabled()
+	if len(cfg.VspHosts) == 0 && !soloVoting {
 		return errors.New("ticket buyer config not set for this wallet")
 	}
 	if cfg.BalanceToMaintain < 0 {
@@ -258,35 +283,45 @@ func (asset *Asset) StartTicketBuyer(passphrase string) error {
 		}
 	}
 
+	// Solo voting wallets sync with SPV peers until the node's password is
+	// decrypted, switch to the node so that the tickets are voted.
+	if soloVoting && asset.unlockSoloVotingNode(passphrase) && (asset.IsSyncing() || asset.IsSynced()) {
+		if err := asset.RestartSpvSync(); err != nil {
+			log.Errorf("[%d] Error switching to the solo voting node: %v", asset.ID, err)
+		}
+	}
+
 	ctx, cancel := asset.ShutdownContextWithCancel()
 	asset.cancelAutoTicketBuyerMu.Lock()
 	asset.cancelAutoTicketBuyer = cancel
+	asset.openTicketBuyerSession(reason)
 	asset.cancelAutoTicketBuyerMu.Unlock()
+	asset.SetBoolConfigValueForKey(sharedW.TicketBuyerWasActiveConfigKey, true)
 
-	// Check the VSP.
-	vspInfo, err := vspInfo(cfg.VspHost)
-	if err != nil {
-		return fmt.Errorf("error setting up vsp client: %v", err)
-	}
-
-	cfg.VspClient, err = asset.VSPClient(cfg.PurchaseAccount, cfg.VspHost, vspInfo.PubKey)
-	if err != nil {
-		log.Errorf("[%d] VSP Client instance failed error: %v", asset.ID, err)
-		return errors.New("VSP Client failed to start due to incorrect configuration")
+	// The VSPs are checked again before every purchase, so the ticket buyer
+	// keeps running even if none of them is usable right now.
+	if !soloVoting {
+		if _, _, err := asset.ticketBuyerVSP(cfg); err != nil {
+			log.Warnf("[%d] %v", asset.ID, err)
+		}
 	}
 
 	go func() {
 		log.Infof("[%d] Running ticket buyer", asset.ID)
 
-		if err = asset.runTicketBuyer(ctx, passphrase, cfg); err != nil {
-			if ctx.Err() != nil {
-				log.Errorf("[%d] Ticket buyer instance canceled", asset.ID)
-			} else {
-				log.Errorf("[%d] Ticket buyer instance errored: %v", asset.ID, err)
-			}
+		err := asset.runTicketBuyer(ctx, passphrase, cfg)
+		if ctx.Err() != nil {
+			// Already stopped, and the session closed, by whoever canceled it.
+			log.Errorf("[%d] Ticket buyer instance canceled", asset.ID)
+			return
 		}
 
-		if err = asset.StopAutoTicketsPurchase(); err != nil {
+		stopReason := TicketBuyerInterrupted
+		if err != nil {
+			log.Errorf("[%d] Ticket buyer instance errored: %v", asset.ID, err)
+			stopReason = err.Error()
+		}
+		if err := asset.stopTicketBuyer(stopReason, false); err != nil {
 			log.Errorf("[%d] Stopping auto ticket purchase errored: %v", asset.ID, err)
 		}
 	}()
@@ -294,6 +329,53 @@ func (asset *Asset) StartTicketBuyer(passphrase string) error {
 	return nil
 }
 
+// ticketBuyerVSP returns the first of the ticket buyer's VSPs that is online,
+// open and charges no more than the maximum fee, together with its client.
+func (asset *Asset) ticketBuyerVSP(cfg *TicketBuyerConfig) (string, *vsp.Client, error) {
+	host, client := asset.firstUsableVSP(cfg.VspHosts, cfg.MaxFeePercent, cfg.PurchaseAccount)
+	if client == nil {
+		return "", nil, errors.New("none of the ticket buyer VSPs is available")
+	}
+	return host, client, nil
+}
+
+// firstUsableVSP returns the first of vspHosts that is online, open and, if
+// maxFeePercent isn't zero, charges no more than maxFeePercent, together with
+// its client. The returned client is nil if none of the VSPs is usable. The
+// VSPs are only asked for their info again once the last reply is stale, see
+// recentVSPInfo.
+func (asset *Asset) firstUsableVSP(vspHosts []string, maxFeePercent float64, account int32) (string, *vsp.Client) {
+	for _, host := range vspHosts {
+		info, err := asset.recentVSPInfo(host)
+		if err != nil {
+			log.Warnf("[%d] Skipping VSP %s: %v", asset.ID, host, err)
+			continue
+		}
+
+		switch {
+		case info.VspClosed:
+			log.Warnf("[%d] Skipping VSP %s: closed: %s", asset.ID, host, info.VspClosedMsg)
+			continue
+		case info.Network != string(asset.NetType()):
+			log.Warnf("[%d] Skipping VSP %s: invalid net %s", asset.ID, host, info.Network)
+			continue
+		case maxFeePercent > 0 && info.FeePercentage > maxFeePercent:
+			log.Warnf("[%d] Skipping VSP %s: fee %.2f%% exceeds the maximum of %.2f%%",
+				asset.ID, host, info.FeePercentage, maxFeePercent)
+			continue
+		}
+
+		client, err := asset.VSPClient(account, host, info.PubKey)
+		if err != nil {
+			log.Errorf("[%d] VSP Client instance for %s failed error: %v", asset.ID, host, err)
+			continue
+		}
+		return host, client
+	}
+
+	return "", nil
+}
+
 // runTicketBuyer executes the ticket buyer. If the private passphrase is
 // incorrect, or ever becomes incorrect due to a wallet passphrase change,
 // runTicketBuyer exits with an errors.Passphrase error.
@@ -315,6 +397,12 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 
 	var nextIntervalStart, expiry int32
 	var cancels []func()
+	// inFlight counts the purchases that are still running, they count
+	// towards the ticket limits until they are added to purchases.
+	var inFlight int32
+	var purchasesMu sync.Mutex
+	var purchases []ticketBuyerPurchase
+	_ = asset.ReadUserConfigValue(sharedW.TicketBuyerPurchasesConfigKey, &purchases)
 	for {
 		select {
 		case <-ctx.Done():
@@ -409,10 +497,42 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 				continue
 			}
 
+			window := ticketPurchaseWindow(height, int32(w.ChainParams().StakeDiffWindowSize))
+			purchasesMu.Lock()
+			allowed, reason := ticketsAllowed(&cfg.TicketBuyerLimits, time.Now(), sdiff, window, purchases, int(atomic.LoadInt32(&inFlight)))
+			purchasesMu.Unlock()
+			if reason != "" {
+				log.Debugf("[%d] Skipping purchase: %s", asset.ID, reason)
+			}
+			if allowed >= 0 && allowed < buy {
+				buy = allowed
+			}
+			if buy == 0 {
+				continue
+			}
+
+			// Solo voting tickets are bought without a VSP.
+			var vspHost string
+			var vspClient *vsp.Client
+			if !asset.IsSoloVotingEnabled() {
+				vspHost, vspClient, err = asset.ticketBuyerVSP(cfg)
+				if err != nil {
+					log.Errorf("[%d] Skipping purchase: %v", asset.ID, err)
+					continue
+				}
+			}
+
 			cancelCtx, cancel := context.WithCancel(ctx)
 			cancels = append(cancels, cancel)
 			buyTicket := func() {
-				err := asset.buyTicket(cancelCtx, passphrase, sdiff, expiry, cfg)
+				defer atomic.AddInt32(&inFlight, -1)
+				err := asset.buyTicket(cancelCtx, passphrase, sdiff, expiry, cfg, vspHost, vspClient)
+				if err == nil {
+					purchasesMu.Lock()
+					purchases = recordTicketPurchase(purchases, time.Now(), window)
+					asset.SaveUserConfigValue(sharedW.TicketBuyerPurchasesConfigKey, purchases)
+					purchasesMu.Unlock()
+				}
 				if err != nil {
 					switch {
 					// silence these errors
@@ -434,14 +554,90 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 			// start separate ticket purchase for as many tickets that can be purchased
 			// each purchase only buy 1 ticket.
 			for i := 0; i < buy; i++ {
+				atomic.AddInt32(&inFlight, 1)
 				go buyTicket()
 			}
 		}
 	}
 }
 
-// buyTicket purchases one ticket with the asset.
-func (asset *Asset) buyTicket(ctx context.Context, passphrase string, sdiff dcrutil.Amount, expiry int32, cfg *TicketBuyerConfig) error {
+// ticketPurchaseWindow returns the first block of the ticket price window a
+// ticket bought after the block at height is mined in. The earliest a ticket
+// may be mined is two blocks later, after its split transaction.
+func ticketPurchaseWindow(height, windowSize int32) int32 {
+	return ((height + 2) / windowSize) * windowSize
+}
+
+// ticketsAllowed returns how many tickets the ticket buyer may buy at now, at
+// the ticket price sdiff and for the ticket price window starting at window,
+// under limits, or -1 if it isn't limited. Only the previous purchases of the
+// ticket buyer count towards the limits, along with the inFlight purchases.
+// If no ticket is allowed, the reason is returned.
+func ticketsAllowed(limits *TicketBuyerLimits, now time.Time, sdiff dcrutil.Amount, window int32,
+	purchases []ticketBuyerPurchase, inFlight int) (int, string) {
+	if !limits.IsActiveAt(now) {
+		return 0, "outside of the active hours"
+	}
+	if limits.MaxTicketPrice > 0 && int64(sdiff) > limits.MaxTicketPrice {
+		return 0, fmt.Sprintf("ticket price %v exceeds the maximum of %v", sdiff, dcrutil.Amount(limits.MaxTicketPrice))
+	}
+	if limits.MaxTicketsPerDay <= 0 && limits.MaxTicketsPerWindow <= 0 {
+		return -1, ""
+	}
+
+	dayStart := startOfDay(now).Unix()
+	boughtToday, boughtInWindow := inFlight, inFlight
+	for _, purchase := range purchases {
+		if purchase.Time >= dayStart {
+			boughtToday++
+		}
+		if purchase.Window == window {
+			boughtInWindow++
+		}
+	}
+
+	allowed := -1
+	var reason string
+	if limits.MaxTicketsPerDay > 0 {
+		allowed = max(int(limits.MaxTicketsPerDay)-boughtToday, 0)
+		if allowed == 0 {
+			reason = "daily ticket limit reached"
+		}
+	}
+	if limits.MaxTicketsPerWindow > 0 {
+		windowAllowed := max(int(limits.MaxTicketsPerWindow)-boughtInWindow, 0)
+		if allowed < 0 || windowAllowed < allowed {
+			allowed = windowAllowed
+			if allowed == 0 {
+				reason = "ticket price window limit reached"
+			}
+		}
+	}
+	return allowed, reason
+}
+
+// recordTicketPurchase adds a purchase made at now for window to purchases,
+// dropping the purchases that no longer count towards any limit.
+func recordTicketPurchase(purchases []ticketBuyerPurchase, now time.Time, window int32) []ticketBuyerPurchase {
+	dayStart := startOfDay(now).Unix()
+	kept := make([]ticketBuyerPurchase, 0, len(purchases)+1)
+	for _, purchase := range purchases {
+		if purchase.Time >= dayStart || purchase.Window >= window {
+			kept = append(kept, purchase)
+		}
+	}
+	return append(kept, ticketBuyerPurchase{Time: now.Unix(), Window: window})
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// buyTicket purchases one ticket with the asset, using the VSP at vspHost, or
+// for solo voting if vspClient is nil.
+func (asset *Asset) buyTicket(ctx context.Context, passphrase string, sdiff dcrutil.Amount, expiry int32,
+	cfg *TicketBuyerConfig, vspHost string, vspClient *vsp.Client) error {
 	ctx, task := trace.NewTask(ctx, "ticketbuyer.buy")
 	defer task.End()
 
@@ -465,17 +661,19 @@ func (asset *Asset) buyTicket(ctx context.Context, passphrase string, sdiff dcru
 	// which can be used to link the tickets eventually purchased with the
 	// split outputs.
 	request := &w.PurchaseTicketsRequest{
-		Count:                1,
-		SourceAccount:        uint32(cfg.PurchaseAccount),
-		Expiry:               expiry,
-		MinConf:              asset.RequiredConfirmations(),
-		VSPFeePercent:        cfg.VspClient.FeePercentage,
-		VSPFeePaymentProcess: cfg.VspClient.Process,
+		Count:         1,
+		SourceAccount: uint32(cfg.PurchaseAccount),
+		Expiry:        expiry,
+		MinConf:       asset.RequiredConfirmations(),
 
 		// VotingAccount used to derive addresses for specifying voting rights.
 		// It is used when VotingAddress == nil, or Mixing == true
 		VotingAccount: uint32(cfg.PurchaseAccount),
 	}
+	if vspClient != nil {
+		request.VSPFeePercent = vspClient.FeePercentage
+		request.VSPFeePaymentProcess = vspClient.Process
+	}
 
 	csppCfg := asset.readCSPPConfig()
 	if csppCfg == nil {
@@ -492,7 +690,11 @@ func (asset *Asset) buyTicket(ctx context.Context, passphrase string, sdiff dcru
 	tix, err := asset.Internal().DCR.PurchaseTickets(ctx, networkBackend, request)
 	if tix != nil {
 		for _, hash := range tix.TicketHashes {
-			log.Infof("[%d] Purchased ticket %v at stake difficulty %v", asset.ID, hash, sdiff)
+			if vspClient == nil {
+				log.Infof("[%d] Purchased solo ticket %v at stake difficulty %v", asset.ID, hash, sdiff)
+				continue
+			}
+			log.Infof("[%d] Purchased ticket %v at stake difficulty %v with VSP %s", asset.ID, hash, sdiff, vspHost)
 		}
 	}
 
@@ -508,6 +710,12 @@ func (asset *Asset) IsAutoTicketsPurchaseActive() bool {
 
 // StopAutoTicketsPurchase stops the automatic ticket buyer.
 func (asset *Asset) StopAutoTicketsPurchase() error {
+	return asset.stopTicketBuyer(TicketBuyerStoppedByUser, false)
+}
+
+// stopTicketBuyer stops the ticket buyer and closes its session with reason.
+// The ticket buyer is resumed on the next unlock only if resumable is true.
+func (asset *Asset) stopTicketBuyer(reason string, resumable bool) error {
 	asset.cancelAutoTicketBuyerMu.Lock()
 	defer asset.cancelAutoTicketBuyerMu.Unlock()
 
@@ -517,14 +725,41 @@ func (asset *Asset) StopAutoTicketsPurchase() error {
 
 	asset.cancelAutoTicketBuyer()
 	asset.cancelAutoTicketBuyer = nil
+	asset.closeTicketBuyerSession(reason)
+	if !resumable {
+		asset.SetBoolConfigValueForKey(sharedW.TicketBuyerWasActiveConfigKey, false)
+	}
 	return nil
 }
 
-// SetAutoTicketsBuyerConfig sets ticket buyer config for the asset.
-func (asset *Asset) SetAutoTicketsBuyerConfig(vspHost string, purchaseAccount int32, amountToMaintain int64) {
+// SetAutoTicketsBuyerConfig sets ticket buyer config for the asset. vspHosts
+// are tried in order for every purchase; maxFeePercent is the highest VSP fee
+// accepted, or zero to accept any fee.
+func (asset *Asset) SetAutoTicketsBuyerConfig(vspHosts []string, maxFeePercent float64, purchaseAccount int32, amountToMaintain int64) {
 	asset.SetLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, amountToMaintain)
 	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, purchaseAccount)
-	asset.SetStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, vspHost)
+	asset.SaveUserConfigValue(sharedW.TicketBuyerVSPHostsConfigKey, vspHosts)
+	asset.SetDoubleConfigValueForKey(sharedW.TicketBuyerMaxVSPFeeConfigKey, maxFeePercent)
+	// The single VSP host used by older versions is replaced by the list.
+	asset.SetStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "")
+}
+
+// SetAutoTicketsBuyerLimits sets the spending limits of the ticket buyer for
+// the asset. They apply the next time the ticket buyer is started.
+func (asset *Asset) SetAutoTicketsBuyerLimits(limits TicketBuyerLimits) error {
+	if limits.MaxTicketPrice < 0 || limits.MaxTicketsPerDay < 0 || limits.MaxTicketsPerWindow < 0 {
+		return errors.E(errors.Invalid, "ticket buyer limits can't be negative")
+	}
+	if limits.ActiveFromHour < 0 || limits.ActiveFromHour > 23 || limits.ActiveToHour < 0 || limits.ActiveToHour > 23 {
+		return errors.E(errors.Invalid, "ticket buyer active hours must be between 0 and 23")
+	}
+
+	asset.SetLongConfigValueForKey(sharedW.TicketBuyerMaxTicketPriceConfigKey, limits.MaxTicketPrice)
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsPerDayConfigKey, limits.MaxTicketsPerDay)
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsPerWindowConfigKey, limits.MaxTicketsPerWindow)
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerActiveFromHourConfigKey, limits.ActiveFromHour)
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerActiveToHourConfigKey, limits.ActiveToHour)
+	return nil
 }
 
 // AutoTicketsBuyerConfig returns the previously set ticket buyer config for
@@ -532,18 +767,43 @@ func (asset *Asset) SetAutoTicketsBuyerConfig(vspHost string, purchaseAccount in
 func (asset *Asset) AutoTicketsBuyerConfig() *TicketBuyerConfig {
 	btm := asset.ReadLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, -1)
 	accNum := asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, -1)
-	vspHost := asset.ReadStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "")
+	maxFee := asset.ReadDoubleConfigValueForKey(sharedW.TicketBuyerMaxVSPFeeConfigKey, 0)
 
 	return &TicketBuyerConfig{
-		VspHost:           vspHost,
+		VspHosts:          asset.ticketBuyerVSPHosts(),
+		MaxFeePercent:     maxFee,
 		PurchaseAccount:   accNum,
 		BalanceToMaintain: btm,
+		TicketBuyerLimits: TicketBuyerLimits{
+			MaxTicketPrice:      asset.ReadLongConfigValueForKey(sharedW.TicketBuyerMaxTicketPriceConfigKey, 0),
+			MaxTicketsPerDay:    asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsPerDayConfigKey, 0),
+			MaxTicketsPerWindow: asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsPerWindowConfigKey, 0),
+			ActiveFromHour:      asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerActiveFromHourConfigKey, 0),
+			ActiveToHour:        asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerActiveToHourConfigKey, 0),
+		},
+	}
+}
+
+// ticketBuyerVSPHosts returns the VSPs of the ticket buyer config, falling
+// back to the single VSP saved by older versions.
+func (asset *Asset) ticketBuyerVSPHosts() []string {
+	var vspHosts []string
+	_ = asset.ReadUserConfigValue(sharedW.TicketBuyerVSPHostsConfigKey, &vspHosts)
+	if len(vspHosts) == 0 {
+		if vspHost := asset.ReadStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, ""); vspHost != "" {
+			vspHosts = []string{vspHost}
+		}
 	}
+	return vspHosts
 }
 
 // TicketBuyerConfigIsSet checks if ticket buyer config is set for the asset.
+// Solo voting wallets don't need any VSP.
 func (asset *Asset) TicketBuyerConfigIsSet() bool {
-	return asset.ReadStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "") != ""
+	if asset.IsSoloVotingEnabled() {
+		return asset.IsTicketBuyerAccountSet()
+	}
+	return len(asset.ticketBuyerVSPHosts()) > 0
 }
 
 // IsTicketBuyerAccountSet checks if ticket buyer account is set for the asset.
@@ -556,8 +816,33 @@ func (asset *Asset) ClearTicketBuyerConfig(_ int) error {
 	asset.SetLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, -1)
 	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, -1)
 	asset.SetStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "")
+	asset.SaveUserConfigValue(sharedW.TicketBuyerVSPHostsConfigKey, []string{})
+	asset.SetDoubleConfigValueForKey(sharedW.TicketBuyerMaxVSPFeeConfigKey, 0)
 
-	return nil
+	return asset.SetAutoTicketsBuyerLimits(TicketBuyerLimits{})
+}
+
+// TicketBuyerVSP returns the host of the VSP the ticket with the provided hash
+// is registered with, as recorded by dcrwallet when the ticket buyer bought it
+// or the fee reconciler moved it. An empty string is returned if the ticket
+// isn't registered with a VSP. Unlike VSPTicketInfo, it doesn't need the
+// wallet to be unlocked.
+func (asset *Asset) TicketBuyerVSP(ticketHash string) string {
+	hash, err := chainhash.NewHashFromStr(ticketHash)
+	if err != nil {
+		return ""
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	ticket, err := asset.Internal().DCR.NewVSPTicket(ctx, hash)
+	if err != nil {
+		return ""
+	}
+	info, err := ticket.VSPTicketInfo(ctx)
+	if err != nil {
+		return ""
+	}
+	return info.Host
 }
 
 // NextTicketPriceRemaining returns the remaning time in seconds of a ticket for the next block,
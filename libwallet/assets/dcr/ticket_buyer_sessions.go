@@ -0,0 +1,183 @@
+package dcr
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// Reasons the ticket buyer is started or stopped for, recorded in its session
+// history. A ticket buyer that stops because of an error records the error
+// message instead.
+const (
+	TicketBuyerStartedByUser     = "started by user"
+	TicketBuyerResumedOnUnlock   = "resumed on unlock"
+	TicketBuyerStoppedByUser     = "stopped by user"
+	TicketBuyerStoppedOnShutdown = "app shutdown"
+	TicketBuyerInterrupted       = "interrupted"
+)
+
+// TicketBuyerSessions returns up to limit runs of the ticket buyer, newest
+// first, or all of them if limit is zero.
+func (asset *Asset) TicketBuyerSessions(limit int) ([]*walletdata.TicketBuyerSession, error) {
+	db := asset.GetWalletDataDb()
+	if db == nil {
+		return nil, utils.ErrDCRNotInitialized
+	}
+	return db.TicketBuyerSessions(limit)
+}
+
+// openTicketBuyerSession records the start of a ticket buyer run. A previous
+// run that was never stopped, e.g. because the app crashed, is recorded as
+// interrupted. It must be called with cancelAutoTicketBuyerMu held.
+func (asset *Asset) openTicketBuyerSession(reason string) {
+	asset.ticketBuyerSession = nil
+	db := asset.GetWalletDataDb()
+	if db == nil {
+		return
+	}
+
+	if last, err := db.TicketBuyerSessions(1); err == nil && len(last) > 0 && last[0].StopReason == "" {
+		last[0].StopReason = TicketBuyerInterrupted
+		if err := db.SaveTicketBuyerSession(last[0]); err != nil {
+			log.Errorf("[%d] Error saving ticket buyer session: %v", asset.ID, err)
+		}
+	}
+
+	session := &walletdata.TicketBuyerSession{
+		Start:       time.Now().Unix(),
+		StartReason: reason,
+	}
+	if err := db.SaveTicketBuyerSession(session); err != nil {
+		log.Errorf("[%d] Error saving ticket buyer session: %v", asset.ID, err)
+		return
+	}
+	asset.ticketBuyerSession = session
+}
+
+// closeTicketBuyerSession records the end of the current ticket buyer run. It
+// must be called with cancelAutoTicketBuyerMu held.
+func (asset *Asset) closeTicketBuyerSession(reason string) {
+	session := asset.ticketBuyerSession
+	asset.ticketBuyerSession = nil
+	db := asset.GetWalletDataDb()
+	if session == nil || db == nil {
+		return
+	}
+
+	session.Stop = time.Now().Unix()
+	session.StopReason = reason
+	if err := db.SaveTicketBuyerSession(session); err != nil {
+		log.Errorf("[%d] Error saving ticket buyer session: %v", asset.ID, err)
+	}
+}
+
+// Shutdown stops the ticket buyer, keeping it marked as active so it can be
+// resumed on the next unlock, and then shuts the wallet down.
+func (asset *Asset) Shutdown() {
+	if asset.IsAutoTicketsPurchaseActive() {
+		_ = asset.stopTicketBuyer(TicketBuyerStoppedOnShutdown, true)
+	}
+	asset.Wallet.Shutdown()
+}
+
+// IsTicketBuyerResumeEnabled returns true if the ticket buyer is restarted
+// after the app is unlocked when it was running as the app exited.
+func (asset *Asset) IsTicketBuyerResumeEnabled() bool {
+	var encrypted []byte
+	_ = asset.ReadUserConfigValue(sharedW.TicketBuyerResumePassphraseConfigKey, &encrypted)
+	return len(encrypted) > 0
+}
+
+// EnableTicketBuyerResume makes the ticket buyer restart after the app is
+// unlocked with startupPassphrase if it was running when the app exited.
+// passphrase is the wallet's spending passphrase the ticket buyer runs with;
+// it's stored encrypted with startupPassphrase, which the caller must have
+// verified, see AssetsManager.EnableTicketBuyerResume. passphrase can only be
+// checked here if the wallet is locked.
+func (asset *Asset) EnableTicketBuyerResume(passphrase, startupPassphrase string) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+	if startupPassphrase == "" {
+		return errors.E(errors.Invalid, "a startup passphrase is required to resume the ticket buyer")
+	}
+
+	if asset.IsLocked() {
+		if err := asset.UnlockWallet(passphrase); err != nil {
+			return utils.TranslateError(err)
+		}
+		asset.LockWallet()
+	}
+
+	encrypted, err := sharedW.EncryptWithPassphrase([]byte(startupPassphrase), passphrase)
+	if err != nil {
+		return err
+	}
+	asset.SaveUserConfigValue(sharedW.TicketBuyerResumePassphraseConfigKey, encrypted)
+	return nil
+}
+
+// DisableTicketBuyerResume stops the ticket buyer from being restarted after
+// the app is unlocked and forgets its passphrase.
+func (asset *Asset) DisableTicketBuyerResume() {
+	asset.SaveUserConfigValue(sharedW.TicketBuyerResumePassphraseConfigKey, []byte{})
+}
+
+// RekeyTicketBuyerResume encrypts the stored ticket buyer passphrase with the
+// new startup passphrase. Resuming is disabled if newStartupPassphrase is
+// empty or the passphrase can't be decrypted with oldStartupPassphrase.
+func (asset *Asset) RekeyTicketBuyerResume(oldStartupPassphrase, newStartupPassphrase string) {
+	if !asset.IsTicketBuyerResumeEnabled() {
+		return
+	}
+
+	passphrase, err := asset.ticketBuyerResumePassphrase(oldStartupPassphrase)
+	if err == nil && newStartupPassphrase != "" {
+		var encrypted []byte
+		encrypted, err = sharedW.EncryptWithPassphrase([]byte(newStartupPassphrase), passphrase)
+		if err == nil {
+			asset.SaveUserConfigValue(sharedW.TicketBuyerResumePassphraseConfigKey, encrypted)
+			return
+		}
+	}
+	if err != nil {
+		log.Errorf("[%d] Unable to keep the ticket buyer passphrase: %v", asset.ID, err)
+	}
+	asset.DisableTicketBuyerResume()
+}
+
+func (asset *Asset) ticketBuyerResumePassphrase(startupPassphrase string) (string, error) {
+	var encrypted []byte
+	err := asset.ReadUserConfigValue(sharedW.TicketBuyerResumePassphraseConfigKey, &encrypted)
+	if err != nil {
+		return "", err
+	}
+	return sharedW.DecryptWithPassphrase([]byte(startupPassphrase), encrypted)
+}
+
+// ResumeTicketBuyer restarts the ticket buyer if resuming is enabled, its
+// config is set and it was running when the app exited. It's called once the
+// app is unlocked with startupPassphrase.
+func (asset *Asset) ResumeTicketBuyer(startupPassphrase string) error {
+	if !asset.IsTicketBuyerResumeEnabled() || !asset.TicketBuyerConfigIsSet() ||
+		!asset.ReadBoolConfigValueForKey(sharedW.TicketBuyerWasActiveConfigKey, false) {
+		return nil
+	}
+
+	passphrase, err := asset.ticketBuyerResumePassphrase(startupPassphrase)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("[%d] Resuming ticket buyer", asset.ID)
+	if err := asset.startTicketBuyer(passphrase, TicketBuyerResumedOnUnlock); err != nil {
+		// Don't retry on every unlock, e.g. if the wallet passphrase changed.
+		asset.SetBoolConfigValueForKey(sharedW.TicketBuyerWasActiveConfigKey, false)
+		return err
+	}
+	return nil
+}
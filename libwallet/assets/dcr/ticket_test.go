@@ -0,0 +1,166 @@
+package dcr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+func localHour(hour int) time.Time {
+	return time.Date(2024, time.May, 15, hour, 30, 0, 0, time.Local)
+}
+
+func TestTicketBuyerLimitsIsActiveAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int32
+		hour     int
+		want     bool
+	}{
+		{name: "no active hours", from: 0, to: 0, hour: 3, want: true},
+		{name: "equal hours", from: 9, to: 9, hour: 20, want: true},
+		{name: "within the hours", from: 9, to: 17, hour: 12, want: true},
+		{name: "at the start hour", from: 9, to: 17, hour: 9, want: true},
+		{name: "at the end hour", from: 9, to: 17, hour: 17, want: false},
+		{name: "before the hours", from: 9, to: 17, hour: 8, want: false},
+		{name: "overnight, before midnight", from: 22, to: 6, hour: 23, want: true},
+		{name: "overnight, after midnight", from: 22, to: 6, hour: 2, want: true},
+		{name: "overnight, at the end hour", from: 22, to: 6, hour: 6, want: false},
+		{name: "overnight, during the day", from: 22, to: 6, hour: 12, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limits := TicketBuyerLimits{ActiveFromHour: tc.from, ActiveToHour: tc.to}
+			if got := limits.IsActiveAt(localHour(tc.hour)); got != tc.want {
+				t.Fatalf("expected active %v at %d:30, got %v", tc.want, tc.hour, got)
+			}
+		})
+	}
+}
+
+func TestTicketsAllowed(t *testing.T) {
+	now := localHour(12)
+	yesterday := now.AddDate(0, 0, -1).Unix()
+	const window = 288
+	sdiff := dcrutil.Amount(100)
+
+	tests := []struct {
+		name       string
+		limits     TicketBuyerLimits
+		purchases  []ticketBuyerPurchase
+		inFlight   int
+		want       int
+		wantReason bool
+	}{
+		{
+			name: "no limits",
+			want: -1,
+		},
+		{
+			name:       "outside of the active hours",
+			limits:     TicketBuyerLimits{ActiveFromHour: 20, ActiveToHour: 22},
+			want:       0,
+			wantReason: true,
+		},
+		{
+			name:       "ticket price above the maximum",
+			limits:     TicketBuyerLimits{MaxTicketPrice: 99},
+			want:       0,
+			wantReason: true,
+		},
+		{
+			name:   "ticket price at the maximum",
+			limits: TicketBuyerLimits{MaxTicketPrice: 100},
+			want:   -1,
+		},
+		{
+			name:   "daily limit counts today's purchases",
+			limits: TicketBuyerLimits{MaxTicketsPerDay: 5},
+			purchases: []ticketBuyerPurchase{
+				{Time: yesterday, Window: window},
+				{Time: now.Unix() - 60, Window: window},
+				{Time: now.Unix() - 30, Window: window},
+			},
+			want: 3,
+		},
+		{
+			name:      "in flight purchases count",
+			limits:    TicketBuyerLimits{MaxTicketsPerDay: 5},
+			purchases: []ticketBuyerPurchase{{Time: now.Unix(), Window: window}},
+			inFlight:  2,
+			want:      2,
+		},
+		{
+			name:   "window limit counts the window's purchases",
+			limits: TicketBuyerLimits{MaxTicketsPerWindow: 3},
+			purchases: []ticketBuyerPurchase{
+				{Time: yesterday, Window: window},
+				{Time: now.Unix(), Window: window - 144},
+			},
+			want: 2,
+		},
+		{
+			name:      "lowest limit applies",
+			limits:    TicketBuyerLimits{MaxTicketsPerDay: 10, MaxTicketsPerWindow: 2},
+			purchases: []ticketBuyerPurchase{{Time: now.Unix(), Window: window}},
+			want:      1,
+		},
+		{
+			name:   "limit reached",
+			limits: TicketBuyerLimits{MaxTicketsPerDay: 2, MaxTicketsPerWindow: 5},
+			purchases: []ticketBuyerPurchase{
+				{Time: now.Unix(), Window: window},
+				{Time: now.Unix(), Window: window},
+			},
+			inFlight:   1,
+			want:       0,
+			wantReason: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, reason := ticketsAllowed(&tc.limits, now, sdiff, window, tc.purchases, tc.inFlight)
+			if got != tc.want {
+				t.Fatalf("expected %d tickets allowed, got %d", tc.want, got)
+			}
+			if (reason != "") != tc.wantReason {
+				t.Fatalf("expected a reason %v, got %q", tc.wantReason, reason)
+			}
+		})
+	}
+}
+
+func TestRecordTicketPurchase(t *testing.T) {
+	now := localHour(12)
+	yesterday := now.AddDate(0, 0, -1).Unix()
+	purchases := []ticketBuyerPurchase{
+		{Time: yesterday, Window: 144},
+		{Time: yesterday, Window: 288},
+		{Time: now.Unix() - 60, Window: 144},
+	}
+
+	got := recordTicketPurchase(purchases, now, 288)
+	want := []ticketBuyerPurchase{
+		{Time: yesterday, Window: 288},
+		{Time: now.Unix() - 60, Window: 144},
+		{Time: now.Unix(), Window: 288},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d purchases, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected purchase %d to be %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	if window := ticketPurchaseWindow(287, 144); window != 288 {
+		t.Fatalf("expected a ticket bought at height 287 to be for window 288, got %d", window)
+	}
+	if window := ticketPurchaseWindow(285, 144); window != 144 {
+		t.Fatalf("expected a ticket bought at height 285 to be for window 144, got %d", window)
+	}
+}
@@ -1,6 +1,7 @@
 package dcr
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -40,16 +41,9 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 		return fmt.Errorf("treasury pikey must be %d bytes", secp256k1.PubKeyBytesLenCompressed)
 	}
 
-	var policy stake.TreasuryVoteT
-	switch newVotingPolicy {
-	case "abstain", "invalid", "":
-		policy = stake.TreasuryVoteInvalid
-	case "yes":
-		policy = stake.TreasuryVoteYes
-	case "no":
-		policy = stake.TreasuryVoteNo
-	default:
-		return fmt.Errorf("invalid policy: unknown policy %q", newVotingPolicy)
+	policy, err := parseTreasuryVote(newVotingPolicy)
+	if err != nil {
+		return err
 	}
 
 	// The wallet will need to be unlocked to sign the API
@@ -80,6 +74,16 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 		}
 	}()
 
+	err = asset.updateVSPTreasuryPolicies(ctx, ticketHash, nil, map[string]string{PiKey: newVotingPolicy})
+	vspPreferenceUpdateSuccess = err == nil
+	return err
+}
+
+// updateVSPTreasuryPolicies sends the provided tspend and treasury key
+// policies to the VSP of the ticket with the provided hash, or to the VSPs of
+// all unspent, unexpired tickets if ticketHash is nil. The wallet must be
+// unlocked to sign the requests.
+func (asset *Asset) updateVSPTreasuryPolicies(ctx context.Context, ticketHash *chainhash.Hash, tspendPolicy, treasuryPolicy map[string]string) error {
 	// If a ticket hash is provided, set the specified vote policy with
 	// the VSP associated with the provided ticket. Otherwise, set the
 	// vote policy with the VSPs associated with all "votable" tickets.
@@ -87,7 +91,7 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 	if ticketHash != nil {
 		ticketHashes = append(ticketHashes, ticketHash)
 	} else {
-		err = asset.Internal().DCR.ForUnspentUnexpiredTickets(ctx, func(hash *chainhash.Hash) error {
+		err := asset.Internal().DCR.ForUnspentUnexpiredTickets(ctx, func(hash *chainhash.Hash) error {
 			ticketHashes = append(ticketHashes, hash)
 			return nil
 		})
@@ -100,10 +104,6 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 	// The first error will be returned to the caller.
 	var firstErr error
 	// Update voting preferences on VSPs if required.
-	policyMap := map[string]string{
-		PiKey: newVotingPolicy,
-	}
-
 	for _, tHash := range ticketHashes {
 		vspTicket, err := asset.Internal().DCR.NewVSPTicket(ctx, tHash)
 		if err != nil {
@@ -116,8 +116,13 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 		}
 
 		vspTicketInfo, err := vspTicket.VSPTicketInfo(ctx)
-		if err != nil && firstErr == nil {
-			if err.Error() != utils.ErrWalletLocked {
+		if errors.Is(err, errors.NotExist) {
+			// Solo ticket, voted by this wallet with the choice saved
+			// above; there is no VSP to update.
+			continue // try next tHash
+		}
+		if err != nil {
+			if firstErr == nil && err.Error() != utils.ErrWalletLocked {
 				// Ignore the wallet is locked error.
 				firstErr = err
 			}
@@ -127,22 +132,51 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 		// Account being set to -1 means the default ticket purchase account will be
 		// used in the ticket policy configuration.
 		vspClient, err := asset.VSPClient(-1, vspTicketInfo.Host, vspTicketInfo.PubKey)
-		if err != nil && firstErr == nil {
-			firstErr = err
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
 			continue // try next tHash
 		}
 
-		err = vspClient.SetVoteChoice(ctx, vspTicket, nil, nil, policyMap)
-		if err != nil && firstErr == nil {
-			firstErr = err
+		err = vspClient.SetVoteChoice(ctx, vspTicket, nil, tspendPolicy, treasuryPolicy)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
 			continue // try next tHash
 		}
 	}
 
-	vspPreferenceUpdateSuccess = firstErr == nil
 	return firstErr
 }
 
+// parseTreasuryVote parses a "yes", "no" or "abstain" treasury vote policy.
+func parseTreasuryVote(policy string) (stake.TreasuryVoteT, error) {
+	switch policy {
+	case "abstain", "invalid", "":
+		return stake.TreasuryVoteInvalid, nil
+	case "yes":
+		return stake.TreasuryVoteYes, nil
+	case "no":
+		return stake.TreasuryVoteNo, nil
+	default:
+		return stake.TreasuryVoteInvalid, fmt.Errorf("invalid policy: unknown policy %q", policy)
+	}
+}
+
+// treasuryVoteString returns the policy name of vote, see parseTreasuryVote.
+func treasuryVoteString(vote stake.TreasuryVoteT) string {
+	switch vote {
+	case stake.TreasuryVoteYes:
+		return "yes"
+	case stake.TreasuryVoteNo:
+		return "no"
+	default:
+		return "abstain"
+	}
+}
+
 // TreasuryPolicies returns saved voting policies for treasury spends
 // per pi key. If a pi key is specified, the policy for that pi key
 // is returned; otherwise the policies for all pi keys are returned.
@@ -167,15 +201,7 @@ func (asset *Asset) TreasuryPolicies(PiKey, tixHash string) ([]*TreasuryKeyPolic
 		if err != nil {
 			return nil, fmt.Errorf("invalid pikey: %w", err)
 		}
-		var policy string
-		switch asset.Internal().DCR.TreasuryKeyPolicy(pikey, ticketHash) {
-		case stake.TreasuryVoteYes:
-			policy = "yes"
-		case stake.TreasuryVoteNo:
-			policy = "no"
-		default:
-			policy = "abstain"
-		}
+		policy := treasuryVoteString(asset.Internal().DCR.TreasuryKeyPolicy(pikey, ticketHash))
 		res := []*TreasuryKeyPolicy{
 			{
 				TicketHash: tixHash,
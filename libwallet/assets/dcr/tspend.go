@@ -0,0 +1,131 @@
+package dcr
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// PendingTSpends returns the treasury spends in the mempool that haven't
+// expired, soonest to expire first, with the wallet's voting policy for each.
+// The wallet learns about them from the network while it's synced.
+func (asset *Asset) PendingTSpends() ([]*TSpend, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	txs := asset.Internal().DCR.GetAllTSpends(ctx)
+	tspends := make([]*TSpend, 0, len(txs))
+	for _, tx := range txs {
+		_, pikey, err := stake.CheckTSpend(tx)
+		if err != nil {
+			continue
+		}
+
+		hash := tx.TxHash()
+		tspend := &TSpend{
+			Hash:   hash.String(),
+			Expiry: tx.Expiry,
+			PiKey:  hex.EncodeToString(pikey),
+			Policy: treasuryVoteString(asset.Internal().DCR.TSpendPolicy(&hash, nil)),
+		}
+		// The first output commits to a random value, the others are paid.
+		for _, output := range tx.TxOut[1:] {
+			tspend.Amount += output.Value
+		}
+		tspends = append(tspends, tspend)
+	}
+
+	sort.Slice(tspends, func(i, j int) bool {
+		return tspends[i].Expiry < tspends[j].Expiry
+	})
+	return tspends, nil
+}
+
+// TSpendPolicy returns the voting policy for the treasury spend with the
+// provided hash. If no policy is set for the treasury spend itself, the
+// policy for the Pi key that signed it applies. If a ticket hash is provided,
+// the policy for that ticket is returned.
+func (asset *Asset) TSpendPolicy(tspendHash, tixHash string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrDCRNotInitialized
+	}
+
+	hash, ticketHash, err := parseTSpendHashes(tspendHash, tixHash)
+	if err != nil {
+		return "", err
+	}
+	return treasuryVoteString(asset.Internal().DCR.TSpendPolicy(hash, ticketHash)), nil
+}
+
+// SetTSpendPolicy saves the voting policy for the treasury spend with the
+// provided hash. It overrides the policy for the Pi key that signed it, so a
+// single spend can be opposed without voting against all spends of the key.
+// Setting it to "abstain" removes the override and the Pi key policy applies
+// again. If a ticket hash is provided, the voting policy is also updated with the VSP
+// controlling the ticket. If a ticket hash isn't provided, the vote choice is
+// saved to the local wallet database and the VSPs controlling all unspent,
+// unexpired tickets are updated to use the specified vote policy.
+func (asset *Asset) SetTSpendPolicy(tspendHash, newVotingPolicy, tixHash, passphrase string) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+
+	hash, ticketHash, err := parseTSpendHashes(tspendHash, tixHash)
+	if err != nil {
+		return err
+	}
+
+	policy, err := parseTreasuryVote(newVotingPolicy)
+	if err != nil {
+		return err
+	}
+
+	// The wallet will need to be unlocked to sign the API
+	// request(s) for setting this voting policy with the VSP.
+	err = asset.UnlockWallet(passphrase)
+	if err != nil {
+		return utils.TranslateError(err)
+	}
+	defer asset.LockWallet()
+
+	currentVotingPolicy := asset.Internal().DCR.TSpendPolicy(hash, ticketHash)
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	err = asset.Internal().DCR.SetTSpendPolicy(ctx, hash, policy, ticketHash)
+	if err != nil {
+		return err
+	}
+
+	err = asset.updateVSPTreasuryPolicies(ctx, ticketHash, map[string]string{hash.String(): newVotingPolicy}, nil)
+	if err != nil {
+		// Updating the treasury spend voting preference with the vsp failed,
+		// revert the locally saved voting preference for the treasury spend.
+		revertError := asset.Internal().DCR.SetTSpendPolicy(ctx, hash, currentVotingPolicy, ticketHash)
+		if revertError != nil {
+			log.Errorf("unable to revert locally saved voting preference: %v", revertError)
+		}
+	}
+	return err
+}
+
+func parseTSpendHashes(tspendHash, tixHash string) (*chainhash.Hash, *chainhash.Hash, error) {
+	hash, err := chainhash.NewHashFromStr(tspendHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid tspend hash: %w", err)
+	}
+
+	var ticketHash *chainhash.Hash
+	if tixHash != "" {
+		ticketHash, err = chainhash.NewHashFromStr(tixHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ticket hash: %w", err)
+		}
+	}
+	return hash, ticketHash, nil
+}
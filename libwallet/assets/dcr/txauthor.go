@@ -31,6 +31,10 @@ type TxAuthor struct {
 	utxos          []*sharedW.UnspentOutput
 	unsignedTx     *txauthor.AuthoredTx
 	needsConstruct bool
+
+	// reusedAddresses are the destination addresses used before, only set in
+	// strict privacy mode.
+	reusedAddresses []string
 }
 
 func (asset *Asset) NewUnsignedTx(sourceAccountNumber int32, utxos []*sharedW.UnspentOutput) error {
@@ -288,6 +292,7 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	if err != nil {
 		return "", utils.TranslateError(err)
 	}
+	asset.unfreezeSpentOutputs(&msgTx)
 	return txHash.String(), asset.updateTxLabel(txHash, transactionLabel)
 }
 
@@ -322,8 +327,12 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 	var changeSource txauthor.ChangeSource
 
 	var sendMax bool
+	var sendMaxAddress string
+	destinationAddresses := make([]string, 0, len(asset.TxAuthoredInfo.destinations))
 	ctx, _ := asset.ShutdownContextWithCancel()
 	for _, destination := range asset.TxAuthoredInfo.destinations {
+		destinationAddresses = append(destinationAddresses, destination.Address)
+
 		if err := asset.validateSendAmount(destination.SendMax, destination.UnitAmount); err != nil {
 			return nil, err
 		}
@@ -344,6 +353,7 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 				return nil, fmt.Errorf("max amount change source error: %v", err)
 			}
 			sendMax = true
+			sendMaxAddress = destination.Address
 		} else {
 			output, err := txhelper.MakeTxOutput(destination.Address, destination.UnitAmount, asset.chainParams)
 			if err != nil {
@@ -376,6 +386,26 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Frozen outputs are only spent when selected manually.
+		unspents = sharedW.UnfrozenOutputs(unspents)
+	}
+
+	asset.TxAuthoredInfo.reusedAddresses = nil
+	if asset.IsStrictPrivacySendEnabled() {
+		// A send max destination receives what would otherwise be change.
+		changeAddress := asset.TxAuthoredInfo.changeAddress
+		if sendMax {
+			changeAddress = sendMaxAddress
+		}
+		if err := asset.enforceStrictPrivacy(ctx, unspents, changeAddress); err != nil {
+			return nil, err
+		}
+
+		reused, err := asset.reusedAddresses(destinationAddresses)
+		if err != nil {
+			log.Errorf("constructTransaction: error checking address reuse: %v", err)
+		}
+		asset.TxAuthoredInfo.reusedAddresses = reused
 	}
 
 	// Use the custom input source function instead of querying the same data from the
@@ -485,10 +515,15 @@ func (asset *Asset) changeSource(ctx context.Context) (txauthor.ChangeSource, er
 		var changeAccount uint32
 
 		// MixedAccountNumber would be -1 if mixer config isn't set.
-		if asset.TxAuthoredInfo.sourceAccountNumber == uint32(asset.MixedAccountNumber()) ||
-			asset.AccountMixerMixChange() {
+		sourceIsMixed := asset.TxAuthoredInfo.sourceAccountNumber == uint32(asset.MixedAccountNumber())
+		switch {
+		case sourceIsMixed && asset.IsStrictPrivacySendEnabled():
+			// Strict privacy mode keeps the change of mixed outputs out of
+			// the unmixed account.
+			changeAccount = asset.TxAuthoredInfo.sourceAccountNumber
+		case sourceIsMixed || asset.AccountMixerMixChange():
 			changeAccount = uint32(asset.UnmixedAccountNumber())
-		} else {
+		default:
 			changeAccount = asset.TxAuthoredInfo.sourceAccountNumber
 		}
 
@@ -2,6 +2,8 @@ package dcr
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"decred.org/dcrwallet/v4/vsp"
 	"decred.org/dcrwallet/v4/wallet"
@@ -67,8 +69,86 @@ type AccountMixerNotificationListener struct {
 	OnAccountMixerEnded   func(walletID int)
 }
 
+// MixingWindow is a time of day the account mixer is allowed to mix in, from
+// FromHour up to ToHour in the local time zone. ToHour may be before FromHour
+// to mix overnight. The window covers the whole day if both are equal.
+type MixingWindow struct {
+	FromHour int32 `json:"from_hour"`
+	ToHour   int32 `json:"to_hour"`
+}
+
+// Contains returns true if t falls within the window.
+func (w MixingWindow) Contains(t time.Time) bool {
+	if w.FromHour == w.ToHour {
+		return true
+	}
+	hour := int32(t.Local().Hour())
+	if w.FromHour < w.ToHour {
+		return hour >= w.FromHour && hour < w.ToHour
+	}
+	return hour >= w.FromHour || hour < w.ToHour
+}
+
+// MixerStats summarizes the mixes the wallet took part in. Amounts are in
+// atoms.
+type MixerStats struct {
+	Mixes        int
+	MixedOutputs int
+	MixedAmount  int64
+	// FeesPaid is the wallet's share of the fees of its mixes.
+	FeesPaid int64
+	// MixesPerDay is the average number of mixes per day since the first mix.
+	MixesPerDay float64
+	// Denominations maps the output denominations the wallet mixed to the
+	// number of outputs it got of each.
+	Denominations map[int64]int
+	// Days holds the mixes of the most recent days with any, newest first.
+	Days []*MixerDayStats
+	// UnmixedShare is the share of the unmixed account's balance in the
+	// combined balance of the mixed and unmixed accounts, between 0 and 1.
+	UnmixedShare float64
+}
+
+// MixerDayStats sums up the mixes of a single day. Amounts are in atoms.
+type MixerDayStats struct {
+	Day          time.Time
+	Mixes        int
+	MixedOutputs int
+	MixedAmount  int64
+	FeesPaid     int64
+}
+
 /** begin ticket-related types */
 
+// VSPFeeReconcileReport describes the progress of a run of the VSP fee
+// reconciler.
+type VSPFeeReconcileReport struct {
+	// Total is the number of unspent, unexpired tickets to check and Checked
+	// the number checked so far.
+	Total   int
+	Checked int
+	// Unpaid is the number of checked tickets whose VSP fee isn't confirmed
+	// yet, including those fixed by this run.
+	Unpaid int
+	// Retried is the number of tickets whose fee payment was retried with
+	// their VSP and Moved the number of tickets moved to another VSP.
+	Retried int
+	Moved   int
+	// Failed is the number of tickets whose fee couldn't be paid.
+	Failed int
+	// Locked is the number of tickets that couldn't be processed because the
+	// wallet is locked.
+	Locked int
+
+	Running bool
+	LastRun time.Time
+}
+
+// VSPFeeReconcilerListener listens for progress of the VSP fee reconciler.
+type VSPFeeReconcilerListener struct {
+	OnVSPFeeReconcileProgress func(walletID int, report *VSPFeeReconcileReport)
+}
+
 type TicketPriceResponse struct {
 	TicketPrice int64
 	Height      int32
@@ -84,14 +164,82 @@ type StakingOverview struct {
 	Expired  int
 }
 
+// SoloVotingNode is the dcrd node a wallet in solo voting mode syncs with.
+// Unlike SPV peers, the node notifies the wallet of the winning tickets of
+// every block so the wallet can vote its tickets itself.
+type SoloVotingNode struct {
+	Host string `json:"host"`
+	User string `json:"user"`
+	// Pass is the RPC password. It's saved encrypted with the wallet's
+	// spending passphrase in EncryptedPass and is only known after the
+	// wallet was unlocked for solo voting, see Asset.SoloVotingNode. Older
+	// versions saved it in plain text.
+	Pass          string `json:"pass,omitempty"`
+	EncryptedPass []byte `json:"encrypted_pass,omitempty"`
+	// CACert is the PEM encoded certificate of the node's RPC server. It
+	// may be empty if the certificate is signed by a known authority.
+	CACert string `json:"ca_cert"`
+}
+
 // TicketBuyerConfig defines configuration parameters for running
 // an automated ticket buyer.
 type TicketBuyerConfig struct {
-	VspHost           string
+	// VspHosts are the VSPs tickets may be bought with, in order of
+	// preference. Each ticket is bought with the first VSP that is online,
+	// open and whose fee doesn't exceed MaxFeePercent.
+	VspHosts []string
+	// MaxFeePercent is the highest VSP fee percentage the ticket buyer
+	// accepts. Zero means any fee is accepted.
+	MaxFeePercent     float64
 	PurchaseAccount   int32
 	BalanceToMaintain int64
 
-	VspClient *vsp.Client
+	TicketBuyerLimits
+}
+
+// TicketBuyerLimits restrict the spending of the automated ticket buyer. The
+// zero value of each field means no limit. Tickets bought manually don't count
+// towards the ticket limits.
+type TicketBuyerLimits struct {
+	// MaxTicketPrice is the highest ticket price in atoms tickets are bought
+	// at.
+	MaxTicketPrice int64
+	// MaxTicketsPerDay caps the tickets the ticket buyer bought since
+	// midnight, local time.
+	MaxTicketsPerDay int32
+	// MaxTicketsPerWindow caps the tickets the ticket buyer bought for the
+	// current ticket price window.
+	MaxTicketsPerWindow int32
+	// ActiveFromHour and ActiveToHour are the local hours, from 0 to 23, tickets
+	// are bought between; from ActiveFromHour until the start of ActiveToHour.
+	// ActiveToHour may be before ActiveFromHour to buy overnight. Tickets are
+	// bought at any time if both are equal.
+	ActiveFromHour int32
+	ActiveToHour   int32
+}
+
+// IsActiveAt returns true if tickets may be bought at t according to the
+// active hours of the limits.
+func (l TicketBuyerLimits) IsActiveAt(t time.Time) bool {
+	if l.ActiveFromHour == l.ActiveToHour {
+		return true
+	}
+	hour := int32(t.Local().Hour())
+	if l.ActiveFromHour < l.ActiveToHour {
+		return hour >= l.ActiveFromHour && hour < l.ActiveToHour
+	}
+	return hour >= l.ActiveFromHour || hour < l.ActiveToHour
+}
+
+// ticketBuyerPurchase is a ticket bought by the ticket buyer, remembered to
+// enforce the daily and per window limits. Tickets bought manually don't
+// count towards these limits.
+type ticketBuyerPurchase struct {
+	// Time is the unix timestamp of the purchase.
+	Time int64 `json:"time"`
+	// Window is the first block of the ticket price window the ticket was
+	// bought for.
+	Window int32 `json:"window"`
 }
 
 // VSPFeeStatus represents the current fee status of a ticket.
@@ -189,6 +337,40 @@ type DcrdataAgenda struct {
 	Mask          uint16 `json:"mask"`
 }
 
+// TicketVote is a vote cast by one of the wallet's tickets, with the agenda
+// choices decoded from its vote bits.
+type TicketVote struct {
+	VoteHash    string `json:"vote_hash"`
+	TicketHash  string `json:"ticket_hash"`
+	Timestamp   int64  `json:"timestamp"`
+	BlockHeight int32  `json:"block_height"`
+	VoteVersion uint32 `json:"vote_version"`
+	VoteBits    uint16 `json:"vote_bits"`
+	// Choices maps the ids of the agendas of VoteVersion to the ids of the
+	// choices the ticket voted for.
+	Choices map[string]string `json:"choices"`
+	// Expected maps the ids of the current agendas to the choices the ticket
+	// is configured to vote for now: its own choices if it has any, or the
+	// wallet's otherwise.
+	Expected map[string]string `json:"expected"`
+	// Overrides are the agendas the ticket's own choices differ from the
+	// wallet's for.
+	Overrides map[string]bool `json:"overrides"`
+}
+
+// Mismatches returns the ids of the agendas the ticket voted differently for
+// than it's configured to vote now, sorted.
+func (tv *TicketVote) Mismatches() []string {
+	var mismatches []string
+	for agendaID, choice := range tv.Choices {
+		if expected, ok := tv.Expected[agendaID]; ok && expected != choice {
+			mismatches = append(mismatches, agendaID)
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches
+}
+
 /** end agenda types */
 
 // TreasuryKeyPolicy records the voting policy for treasury spend transactions
@@ -199,3 +381,13 @@ type TreasuryKeyPolicy struct {
 	TicketHash string `json:"ticket_hash"` // nil unless for per-ticket VSP policies
 	Policy     string `json:"policy"`
 }
+
+// TSpend is a pending treasury spend transaction the wallet's tickets can vote
+// on, with the wallet's voting policy for it. Amount is in atoms.
+type TSpend struct {
+	Hash   string `json:"hash"`
+	Amount int64  `json:"amount"`
+	Expiry uint32 `json:"expiry"`
+	PiKey  string `json:"pi_key"`
+	Policy string `json:"policy"`
+}
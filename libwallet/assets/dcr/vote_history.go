@@ -0,0 +1,89 @@
+package dcr
+
+import (
+	"strconv"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+// TicketVotes returns the votes cast by the wallet's tickets, newest first,
+// with the agenda choices decoded from their vote bits next to the choices
+// the tickets are configured to vote for now.
+func (asset *Asset) TicketVotes() ([]*TicketVote, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	txs, err := asset.GetTransactionsRaw(0, 0, TxFilterVoted, true, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	walletChoices, _, err := asset.Internal().DCR.AgendaChoices(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]*TicketVote, 0, len(txs))
+	for _, tx := range txs {
+		msgTx, err := decodeMsgTx(tx.Hex)
+		if err != nil {
+			log.Warnf("[%d] Unable to decode vote %s: %v", asset.ID, tx.Hash, err)
+			continue
+		}
+		voteVersion, _, voteBits, ticketHash := voteInfo(msgTx)
+		bits, err := strconv.ParseUint(voteBits, 0, 16)
+		if err != nil {
+			continue
+		}
+
+		vote := &TicketVote{
+			VoteHash:    tx.Hash,
+			TicketHash:  ticketHash,
+			Timestamp:   tx.Timestamp,
+			BlockHeight: tx.BlockHeight,
+			VoteVersion: voteVersion,
+			VoteBits:    uint16(bits),
+			Choices:     decodeVoteChoices(asset.chainParams, voteVersion, uint16(bits)),
+			Expected:    walletChoices,
+			Overrides:   make(map[string]bool),
+		}
+
+		hash, err := chainhash.NewHashFromStr(ticketHash)
+		if err == nil {
+			// The wallet's choices are returned if the ticket has none.
+			ticketChoices, _, err := asset.Internal().DCR.AgendaChoices(ctx, hash)
+			if err == nil {
+				vote.Expected = ticketChoices
+				for agendaID, choice := range ticketChoices {
+					if walletChoices[agendaID] != choice {
+						vote.Overrides[agendaID] = true
+					}
+				}
+			}
+		}
+
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+// decodeVoteChoices returns the choices voteBits select for the agendas of
+// voteVersion, by agenda id.
+func decodeVoteChoices(params *chaincfg.Params, voteVersion uint32, voteBits uint16) map[string]string {
+	choices := make(map[string]string)
+	for _, deployment := range params.Deployments[voteVersion] {
+		agenda := deployment.Vote
+		bits := voteBits & agenda.Mask
+		for _, choice := range agenda.Choices {
+			if choice.Bits == bits {
+				choices[agenda.Id] = choice.Id
+				break
+			}
+		}
+	}
+	return choices
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"decred.org/dcrwallet/v4/vsp"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
@@ -17,8 +18,20 @@ import (
 
 const (
 	defaultVSPsURL = "https://api.decred.org/?c=vsp"
+
+	// vspInfoCacheDuration is how long the ticket buyer reuses the vspinfo
+	// reply of a VSP, or the error fetching it, before asking again.
+	vspInfoCacheDuration = 10 * time.Minute
 )
 
+// cachedVSPInfo is a vspinfo reply, or the error fetching it, and the time it
+// was fetched.
+type cachedVSPInfo struct {
+	info      *vspd.VspInfoResponse
+	err       error
+	fetchedAt time.Time
+}
+
 // VSPClient loads or creates a VSP client instance for the specified host.
 func (asset *Asset) VSPClient(account int32, host string, pubKey []byte) (*vsp.Client, error) {
 	if !asset.WalletOpened() {
@@ -211,6 +224,24 @@ func vspInfo(vspHost string) (*vspd.VspInfoResponse, error) {
 	return vspInfoResponse, err
 }
 
+// recentVSPInfo returns the vspinfo reply of vspHost, fetching it only if the
+// last reply is older than vspInfoCacheDuration.
+func (asset *Asset) recentVSPInfo(vspHost string) (*vspd.VspInfoResponse, error) {
+	asset.vspInfoCacheMu.Lock()
+	defer asset.vspInfoCacheMu.Unlock()
+
+	if cached, ok := asset.vspInfoCache[vspHost]; ok && time.Since(cached.fetchedAt) < vspInfoCacheDuration {
+		return cached.info, cached.err
+	}
+
+	info, err := vspInfo(vspHost)
+	if asset.vspInfoCache == nil {
+		asset.vspInfoCache = make(map[string]*cachedVSPInfo)
+	}
+	asset.vspInfoCache[vspHost] = &cachedVSPInfo{info: info, err: err, fetchedAt: time.Now()}
+	return info, err
+}
+
 // defaultVSPs returns a list of known VSPs.
 func defaultVSPs() (map[string]*vspd.VspInfoResponse, error) {
 	var vspInfoResponse map[string]*vspd.VspInfoResponse
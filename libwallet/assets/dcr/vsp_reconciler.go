@@ -0,0 +1,270 @@
+package dcr
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"decred.org/dcrwallet/v4/wallet/udb"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// vspFeeReconcileInterval is how often the VSP fees of the wallet's tickets
+// are checked in the background.
+const vspFeeReconcileInterval = time.Hour
+
+// ticketFeeOutcome is the result of reconciling the VSP fee of a ticket.
+type ticketFeeOutcome uint8
+
+const (
+	ticketFeeConfirmed ticketFeeOutcome = iota
+	ticketFeeNoVSP
+	ticketFeeRetried
+	ticketFeeMoved
+	ticketFeeFailed
+	ticketFeeLocked
+)
+
+// AddVSPFeeReconcilerListener registers a listener for the progress of the VSP
+// fee reconciler.
+func (asset *Asset) AddVSPFeeReconcilerListener(listener *VSPFeeReconcilerListener, uniqueIdentifier string) error {
+	asset.notificationListenersMu.Lock()
+	defer asset.notificationListenersMu.Unlock()
+
+	if _, ok := asset.vspReconcilerListeners[uniqueIdentifier]; ok {
+		return errors.New(utils.ErrListenerAlreadyExist)
+	}
+
+	asset.vspReconcilerListeners[uniqueIdentifier] = listener
+	return nil
+}
+
+// RemoveVSPFeeReconcilerListener removes a listener added with
+// AddVSPFeeReconcilerListener.
+func (asset *Asset) RemoveVSPFeeReconcilerListener(uniqueIdentifier string) {
+	asset.notificationListenersMu.Lock()
+	defer asset.notificationListenersMu.Unlock()
+
+	delete(asset.vspReconcilerListeners, uniqueIdentifier)
+}
+
+func (asset *Asset) publishVSPFeeReconcileProgress(report VSPFeeReconcileReport) {
+	asset.vspReconcileReportMu.Lock()
+	asset.vspReconcileReport = &report
+	asset.vspReconcileReportMu.Unlock()
+
+	asset.notificationListenersMu.RLock()
+	defer asset.notificationListenersMu.RUnlock()
+
+	for _, listener := range asset.vspReconcilerListeners {
+		if listener.OnVSPFeeReconcileProgress != nil {
+			r := report
+			listener.OnVSPFeeReconcileProgress(asset.ID, &r)
+		}
+	}
+}
+
+// VSPFeeReconcileReport returns the progress of the current or last run of the
+// VSP fee reconciler, or nil if it hasn't run yet.
+func (asset *Asset) VSPFeeReconcileReport() *VSPFeeReconcileReport {
+	asset.vspReconcileReportMu.RLock()
+	defer asset.vspReconcileReportMu.RUnlock()
+
+	if asset.vspReconcileReport == nil {
+		return nil
+	}
+	report := *asset.vspReconcileReport
+	return &report
+}
+
+// startVSPFeeReconciler checks the VSP fees of the wallet's tickets now and
+// then every vspFeeReconcileInterval until the wallet is shut down. Fees are
+// only paid if the wallet is unlocked at that time, e.g. by the ticket buyer;
+// otherwise the tickets needing attention are reported so the user can run
+// ReconcileVSPFees. It's a no-op after the first call.
+func (asset *Asset) startVSPFeeReconciler() {
+	asset.vspReconcilerOnce.Do(func() {
+		go func() {
+			ctx, _ := asset.ShutdownContextWithCancel()
+			ticker := time.NewTicker(vspFeeReconcileInterval)
+			defer ticker.Stop()
+
+			for {
+				if err := asset.reconcileVSPFees(ctx); err != nil {
+					log.Errorf("[%d] VSP fee reconciliation failed: %v", asset.ID, err)
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	})
+}
+
+// ReconcileVSPFees unlocks the wallet with passphrase and retries the fee
+// payment of every unspent, unexpired ticket whose VSP fee isn't confirmed.
+// Tickets whose VSP is unavailable and that have no fee transaction yet are
+// moved to the first usable ticket buyer or known VSP. It returns once the
+// wallet is unlocked; the tickets are processed in the background and the
+// progress is reported to the VSPFeeReconcilerListeners.
+func (asset *Asset) ReconcileVSPFees(passphrase string) error {
+	if !asset.WalletOpened() {
+		return utils.ErrDCRNotInitialized
+	}
+
+	wasLocked := asset.IsLocked()
+	if wasLocked {
+		if err := asset.UnlockWallet(passphrase); err != nil {
+			return utils.TranslateError(err)
+		}
+	}
+
+	go func() {
+		ctx, _ := asset.ShutdownContextWithCancel()
+		if err := asset.reconcileVSPFees(ctx); err != nil {
+			log.Errorf("[%d] VSP fee reconciliation failed: %v", asset.ID, err)
+		}
+		// Keep the wallet unlocked if the ticket buyer is relying on it.
+		if wasLocked && !asset.IsAutoTicketsPurchaseActive() {
+			asset.LockWallet()
+		}
+	}()
+
+	return nil
+}
+
+func (asset *Asset) reconcileVSPFees(ctx context.Context) error {
+	if !asset.vspReconcileMu.TryLock() {
+		return errors.E(errors.Invalid, "VSP fee reconciliation already running")
+	}
+	defer asset.vspReconcileMu.Unlock()
+
+	tickets, err := asset.UnspentUnexpiredTickets()
+	if err != nil {
+		return err
+	}
+
+	report := VSPFeeReconcileReport{Total: len(tickets), Running: true}
+	asset.publishVSPFeeReconcileProgress(report)
+	defer func() {
+		report.Running = false
+		report.LastRun = time.Now()
+		asset.publishVSPFeeReconcileProgress(report)
+	}()
+
+	for _, ticket := range tickets {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		outcome := asset.reconcileTicketFee(ctx, ticket.Hash)
+		report.Checked++
+		if outcome != ticketFeeConfirmed && outcome != ticketFeeNoVSP {
+			report.Unpaid++
+		}
+		switch outcome {
+		case ticketFeeRetried:
+			report.Retried++
+		case ticketFeeMoved:
+			report.Moved++
+		case ticketFeeFailed:
+			report.Failed++
+		case ticketFeeLocked:
+			report.Locked++
+		}
+		asset.publishVSPFeeReconcileProgress(report)
+	}
+
+	return nil
+}
+
+// reconcileTicketFee makes sure the VSP fee of the ticket with the provided
+// hash gets paid and confirmed, see ReconcileVSPFees.
+func (asset *Asset) reconcileTicketFee(ctx context.Context, hash string) ticketFeeOutcome {
+	ticketHash, err := chainhash.NewHashFromStr(hash)
+	if err != nil {
+		return ticketFeeFailed
+	}
+
+	dcrWallet := asset.Internal().DCR
+	ticket, err := dcrWallet.NewVSPTicket(ctx, ticketHash)
+	if err != nil {
+		log.Errorf("[%d] Unable to load ticket %s: %v", asset.ID, hash, err)
+		return ticketFeeFailed
+	}
+
+	info, err := ticket.VSPTicketInfo(ctx)
+	if errors.Is(err, errors.NotExist) {
+		// Solo ticket, or one that was never registered with a VSP.
+		return ticketFeeNoVSP
+	}
+	if err != nil {
+		log.Errorf("[%d] Unable to read VSP info of ticket %s: %v", asset.ID, hash, err)
+		return ticketFeeFailed
+	}
+
+	feeStatus := udb.FeeStatus(info.FeeTxStatus)
+	if feeStatus == udb.VSPFeeProcessConfirmed {
+		return ticketFeeConfirmed
+	}
+	if dcrWallet.Locked() {
+		return ticketFeeLocked
+	}
+
+	account := int32(-1)
+	if asset.IsTicketBuyerAccountSet() {
+		account = asset.AutoTicketsBuyerConfig().PurchaseAccount
+	}
+
+	// Retry with the ticket's own VSP first.
+	if _, client := asset.firstUsableVSP([]string{info.Host}, 0, account); client != nil {
+		err = client.Process(ctx, ticket, nil)
+		if err == nil {
+			log.Infof("[%d] Retried the VSP fee payment of ticket %s with %s", asset.ID, hash, info.Host)
+			return ticketFeeRetried
+		}
+		log.Warnf("[%d] Retrying the VSP fee payment of ticket %s with %s failed: %v", asset.ID, hash, info.Host, err)
+	}
+
+	// A ticket can only be moved if no fee was paid to, or created for, its
+	// VSP yet; an existing fee transaction pays that VSP's fee address.
+	if feeStatus == udb.VSPFeeProcessPaid {
+		return ticketFeeFailed
+	}
+	if _, err := ticket.FeeHash(ctx); err == nil {
+		return ticketFeeFailed
+	}
+
+	// Prefer the ticket buyer's VSPs, in their order, to the other known VSPs.
+	cfg := asset.AutoTicketsBuyerConfig()
+	var candidates []string
+	seen := map[string]bool{info.Host: true}
+	for _, host := range cfg.VspHosts {
+		if !seen[host] {
+			seen[host] = true
+			candidates = append(candidates, host)
+		}
+	}
+	for _, known := range asset.KnownVSPs() {
+		if !seen[known.Host] {
+			seen[known.Host] = true
+			candidates = append(candidates, known.Host)
+		}
+	}
+
+	host, client := asset.firstUsableVSP(candidates, cfg.MaxFeePercent, account)
+	if client == nil {
+		return ticketFeeFailed
+	}
+	if err := client.Process(ctx, ticket, nil); err != nil {
+		log.Errorf("[%d] Moving ticket %s to %s failed: %v", asset.ID, hash, host, err)
+		return ticketFeeFailed
+	}
+
+	log.Infof("[%d] Moved ticket %s from %s to %s", asset.ID, hash, info.Host, host)
+	return ticketFeeMoved
+}
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 
 	"decred.org/dcrwallet/v4/vsp"
 	dcrW "decred.org/dcrwallet/v4/wallet"
 	"decred.org/dcrwallet/v4/wallet/txrules"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
 	"github.com/crypto-power/cryptopower/libwallet/internal/loader"
 	"github.com/crypto-power/cryptopower/libwallet/internal/loader/dcr"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
@@ -26,8 +28,12 @@ type Asset struct {
 	chainParams *chaincfg.Params
 
 	cancelAccountMixer      context.CancelFunc `json:"-"`
+	mixerWaiting            atomic.Bool
 	cancelAutoTicketBuyer   context.CancelFunc `json:"-"`
 	cancelAutoTicketBuyerMu sync.RWMutex
+	// ticketBuyerSession is the running ticket buyer session, protected by
+	// cancelAutoTicketBuyerMu.
+	ticketBuyerSession *walletdata.TicketBuyerSession
 
 	TxAuthoredInfo *TxAuthor
 
@@ -36,6 +42,29 @@ type Asset struct {
 	vspMu      sync.RWMutex
 	vsps       []*VSP
 
+	// vspInfoCache holds the recent vspinfo replies of the VSPs the ticket
+	// buyer and the fee reconciler choose from, keyed by host.
+	vspInfoCache   map[string]*cachedVSPInfo
+	vspInfoCacheMu sync.Mutex
+
+	// usedAddressesCache holds the output addresses of the wallet's
+	// transactions for the address reuse check of strict privacy mode.
+	usedAddressesMu      sync.Mutex
+	usedAddressesCache   map[string]bool
+	usedAddressesTxCount int
+
+	// VSP fee reconciler data, see vsp_reconciler.go.
+	vspReconcilerOnce      sync.Once
+	vspReconcileMu         sync.Mutex
+	vspReconcileReportMu   sync.RWMutex
+	vspReconcileReport     *VSPFeeReconcileReport
+	vspReconcilerListeners map[string]*VSPFeeReconcilerListener
+
+	// soloVotingPass is the decrypted RPC password of the solo voting node,
+	// see SoloVotingNode.
+	soloVotingPassMu sync.Mutex
+	soloVotingPass   string
+
 	notificationListenersMu           sync.RWMutex
 	syncData                          *SyncData
 	accountMixerNotificationListeners map[string]*AccountMixerNotificationListener
@@ -63,8 +92,10 @@ func initWalletLoader(chainParams *chaincfg.Params, rootdir, walletDbDriver stri
 		MixSplitLimit:           10,
 	}
 
+	// Votes are only cast when syncing with a solo voting node, SPV peers
+	// don't announce the winning tickets.
 	stakeOptions := &dcr.StakeOptions{
-		VotingEnabled: false,
+		VotingEnabled: true,
 		VotingAddress: nil,
 	}
 
@@ -126,6 +157,7 @@ func CreateNewWallet(pass *sharedW.AuthInfo, params *sharedW.InitParams) (shared
 		},
 		txAndBlockNotificationListeners:   make(map[string]*sharedW.TxAndBlockNotificationListener),
 		accountMixerNotificationListeners: make(map[string]*AccountMixerNotificationListener),
+		vspReconcilerListeners:            make(map[string]*VSPFeeReconcilerListener),
 		vspClients:                        make(map[string]*vsp.Client),
 		dbMutex:                           &dbMutex,
 	}
@@ -165,6 +197,7 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		},
 		txAndBlockNotificationListeners:   make(map[string]*sharedW.TxAndBlockNotificationListener),
 		accountMixerNotificationListeners: make(map[string]*AccountMixerNotificationListener),
+		vspReconcilerListeners:            make(map[string]*VSPFeeReconcilerListener),
 		dbMutex:                           &dbMutex,
 	}
 
@@ -202,6 +235,7 @@ func RestoreWallet(seedMnemonic string, pass *sharedW.AuthInfo, params *sharedW.
 		vspClients:                        make(map[string]*vsp.Client),
 		txAndBlockNotificationListeners:   make(map[string]*sharedW.TxAndBlockNotificationListener),
 		accountMixerNotificationListeners: make(map[string]*AccountMixerNotificationListener),
+		vspReconcilerListeners:            make(map[string]*VSPFeeReconcilerListener),
 		dbMutex:                           &dbMutex,
 	}
 
@@ -234,6 +268,7 @@ func LoadExisting(w *sharedW.Wallet, params *sharedW.InitParams) (sharedW.Asset,
 		},
 		txAndBlockNotificationListeners:   make(map[string]*sharedW.TxAndBlockNotificationListener),
 		accountMixerNotificationListeners: make(map[string]*AccountMixerNotificationListener),
+		vspReconcilerListeners:            make(map[string]*VSPFeeReconcilerListener),
 		dbMutex:                           &dbMutex,
 	}
 
@@ -115,9 +115,14 @@ func (asset *Asset) GetAccountBalance(accountNumber int32) (*sharedW.Balance, er
 		return nil, err
 	}
 
+	frozenAmount, err := asset.frozenAmount(accountNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	return &sharedW.Balance{
 		Total:          Amount(balance.Total),
-		Spendable:      Amount(balance.Spendable - lockedAmount),
+		Spendable:      Amount(balance.Spendable - lockedAmount - frozenAmount),
 		ImmatureReward: Amount(balance.ImmatureReward),
 		Locked:         Amount(lockedAmount),
 	}, nil
@@ -155,7 +160,21 @@ func (asset *Asset) SpendableForAccount(account int32) (int64, error) {
 		return 0, err
 	}
 
-	return int64(bals.Spendable - lockedAmount), nil
+	frozenAmount, err := asset.frozenAmount(account)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(bals.Spendable - lockedAmount - frozenAmount), nil
+}
+
+// frozenAmount is the total value of the frozen outputs of the provided
+// account, which only manual coin selection can spend.
+func (asset *Asset) frozenAmount(account int32) (ltcutil.Amount, error) {
+	amount, err := asset.FrozenAmount(func() ([]*sharedW.UnspentOutput, error) {
+		return asset.UnspentOutputs(account)
+	})
+	return ltcutil.Amount(amount), err
 }
 
 // UnspentOutputs returns all the unspent outputs available for the provided
@@ -201,6 +220,10 @@ func (asset *Asset) UnspentOutputs(account int32) ([]*sharedW.UnspentOutput, err
 		})
 	}
 
+	if err := asset.MarkFrozenOutputs(resp); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
@@ -299,3 +322,12 @@ func (asset *Asset) HDPathForAccount(accountNumber int32) (string, error) {
 
 	return hdPath + strconv.Itoa(int(accountNumber)), nil
 }
+
+// pruneFrozenOutputs removes the outputs spent since they were frozen from the
+// frozen outputs. It must only be called once the wallet is synced.
+func (asset *Asset) pruneFrozenOutputs() {
+	err := asset.PruneFrozenOutputs(asset.GetAccountsRaw, asset.UnspentOutputs, asset.UnfreezeOutput)
+	if err != nil {
+		log.Errorf("[%d] Error pruning frozen outputs: %v", asset.ID, err)
+	}
+}
@@ -0,0 +1,226 @@
+package ltc
+
+import (
+	"bytes"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/dcrlabs/ltcwallet/wallet/txauthor"
+	"github.com/dcrlabs/ltcwallet/wallet/txsizes"
+	"github.com/ltcsuite/ltcd/blockchain"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+// CanCPFP returns true if txHash is an unmined transaction with outputs paying
+// to this wallet that can be spent to accelerate it.
+func (asset *Asset) CanCPFP(txHash string) bool {
+	if !asset.WalletOpened() || asset.IsWatchingOnlyWallet() {
+		return false
+	}
+	_, err := asset.cpfpParent(txHash)
+	return err == nil
+}
+
+// PrepareCPFP computes the child-pays-for-parent transaction that would bring
+// the unmined transaction txHash and the child, taken as a package, to
+// feeRatePerkvB (in Lit/kvB). Nothing is signed or broadcast.
+func (asset *Asset) PrepareCPFP(txHash string, feeRatePerkvB int64) (*sharedW.CPFPInfo, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrLTCNotInitialized
+	}
+
+	// The preview pays its change to a placeholder script, so that no change
+	// address is used up until the child is broadcast.
+	_, info, err := asset.constructCPFPTx(txHash, feeRatePerkvB, true)
+	return info, err
+}
+
+// CPFP spends the unconfirmed outputs of txHash that pay to this wallet back
+// to the wallet, with a fee chosen so that the parent and the child pay
+// feeRatePerkvB (in Lit/kvB) as a package. Returns the child's hash.
+func (asset *Asset) CPFP(txHash string, feeRatePerkvB int64, privatePassphrase string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrLTCNotInitialized
+	}
+	if asset.IsWatchingOnlyWallet() {
+		return "", errors.New(utils.ErrWalletIsWatchOnly)
+	}
+
+	childTx, _, err := asset.constructCPFPTx(txHash, feeRatePerkvB, false)
+	if err != nil {
+		return "", err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+
+	err = asset.Internal().LTC.Unlock([]byte(privatePassphrase), lock)
+	if err != nil {
+		log.Errorf("unlocking the wallet failed: %v", err)
+		return "", errors.New(utils.ErrInvalidPassphrase)
+	}
+
+	msgTx := childTx.Tx
+	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
+	if err = asset.signTransaction(msgTx); err != nil {
+		return "", err
+	}
+
+	err = asset.Internal().LTC.PublishTransaction(msgTx, "")
+	if err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	childHash := msgTx.TxHash()
+	log.Infof("Accelerated tx %s with child tx %s", txHash, childHash)
+	return childHash.String(), nil
+}
+
+// cpfpParent is an unmined transaction with outputs paying to this wallet.
+type cpfpParent struct {
+	tx *wire.MsgTx
+	// fee is only known if all the parent's inputs are ours, otherwise
+	// it's zero.
+	fee ltcutil.Amount
+	// account is the account the outputs to this wallet belong to.
+	account     uint32
+	inputSource txauthor.InputSource
+	numInputs   int
+}
+
+// cpfpParent returns the unmined transaction txHash along with its unspent
+// outputs to this wallet.
+func (asset *Asset) cpfpParent(txHash string) (*cpfpParent, error) {
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+
+	txResult, err := asset.Internal().LTC.GetTransaction(*hash)
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+	if txResult.BlockHash != nil {
+		return nil, errors.E(errors.Invalid, "transaction is already mined")
+	}
+
+	parentTx := new(wire.MsgTx)
+	if err = parentTx.Deserialize(bytes.NewReader(txResult.Summary.Transaction)); err != nil {
+		return nil, err
+	}
+
+	// Unconfirmed outputs have zero confirmations.
+	unspents, err := asset.Internal().LTC.ListUnspent(0, 0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		account   uint32
+		inputs    []*wire.TxIn
+		values    []ltcutil.Amount
+		pkScripts [][]byte
+	)
+	for _, output := range txResult.Summary.MyOutputs {
+		for _, unspent := range unspents {
+			if unspent.TxID != txHash || unspent.Vout != output.Index || !unspent.Spendable {
+				continue
+			}
+
+			amount, err := ltcutil.NewAmount(unspent.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			txIn := wire.NewTxIn(wire.NewOutPoint(hash, output.Index), nil, nil)
+			txIn.Sequence = rbfSequence
+			account = output.Account
+			inputs = append(inputs, txIn)
+			values = append(values, amount)
+			pkScripts = append(pkScripts, parentTx.TxOut[output.Index].PkScript)
+		}
+	}
+	if len(inputs) == 0 {
+		return nil, errors.E(errors.Invalid, "transaction has no unspent outputs paying to this wallet")
+	}
+
+	parent := &cpfpParent{
+		tx:          parentTx,
+		account:     account,
+		inputSource: txhelper.MakeLTCTxInputSource(inputs, values, pkScripts),
+		numInputs:   len(inputs),
+	}
+	if len(txResult.Summary.MyInputs) == len(parentTx.TxIn) {
+		parent.fee = txResult.Summary.Fee
+	}
+	return parent, nil
+}
+
+// constructCPFPTx builds the unsigned child transaction for txHash. If
+// estimateOnly is true, the child pays to a placeholder script of the size of
+// a change script instead of a new change address, and must not be
+// broadcast.
+func (asset *Asset) constructCPFPTx(txHash string, feeRatePerkvB int64, estimateOnly bool) (*txauthor.AuthoredTx, *sharedW.CPFPInfo, error) {
+	if ltcutil.Amount(feeRatePerkvB) < MinFeeRatePerkvB {
+		return nil, nil, errors.E(errors.Invalid, "fee rate is below the minimum")
+	}
+
+	parent, err := asset.cpfpParent(txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// When the parent fee is unknown the child pays for the whole package.
+	parentFee := parent.fee
+	parentSize := int((blockchain.GetTransactionWeight(ltcutil.NewTx(parent.tx)) +
+		blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor)
+
+	childSize := txsizes.EstimateVirtualSize(0, 0, parent.numInputs, 0, nil, txsizes.P2WPKHPkScriptSize)
+	childFee := txhelper.CPFPChildFee(int64(parentFee), parentSize, childSize, feeRatePerkvB)
+	childFeeRate := txhelper.CPFPChildFeeRate(childFee, childSize)
+
+	var changeSource *txauthor.ChangeSource
+	if estimateOnly {
+		changeSource = txhelper.MakeLTCTxPlaceholderChangeSource(txsizes.P2WPKHPkScriptSize)
+	} else {
+		address, err := asset.Internal().LTC.NewChangeAddress(parent.account, GetScope())
+		if err != nil {
+			return nil, nil, err
+		}
+		changeSource, err = txhelper.MakeLTCTxChangeSource(address.String(), asset.chainParams)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	childTx, err := txauthor.NewUnsignedTransaction(nil, ltcutil.Amount(childFeeRate), parent.inputSource, changeSource)
+	if err != nil {
+		return nil, nil, err
+	}
+	if childTx.ChangeIndex < 0 {
+		return nil, nil, errors.New(utils.ErrInsufficientBalance)
+	}
+
+	childAmount := ltcutil.Amount(childTx.Tx.TxOut[childTx.ChangeIndex].Value)
+	actualChildFee := childTx.TotalInput - childAmount
+
+	info := &sharedW.CPFPInfo{
+		ParentHash:     txHash,
+		ParentSize:     parentSize,
+		ParentFee:      &sharedW.Amount{UnitValue: int64(parentFee), CoinValue: parentFee.ToBTC()},
+		ParentFeeKnown: parentFee > 0,
+		ChildSize:      childSize,
+		ChildFee:       &sharedW.Amount{UnitValue: int64(actualChildFee), CoinValue: actualChildFee.ToBTC()},
+		ChildAmount:    &sharedW.Amount{UnitValue: int64(childAmount), CoinValue: childAmount.ToBTC()},
+		TargetFeeRate:  feeRatePerkvB,
+		PackageFeeRate: txhelper.PackageFeeRate(int64(parentFee+actualChildFee), parentSize+childSize),
+	}
+	return childTx, info, nil
+}
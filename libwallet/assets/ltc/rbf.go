@@ -0,0 +1,195 @@
+package ltc
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/dcrlabs/ltcwallet/wallet/txrules"
+	"github.com/dcrlabs/ltcwallet/wallet/txsizes"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+// rbfSequence is the input sequence number used to signal BIP-125
+// replaceability. Any value below wire.MaxTxInSequenceNum-1 opts in.
+const rbfSequence = wire.MaxTxInSequenceNum - 2
+
+// CanBumpFee returns true if txHash is an unmined transaction sent by this
+// wallet that signals replaceability and has a change output the extra fee
+// can be taken from.
+func (asset *Asset) CanBumpFee(txHash string) bool {
+	if !asset.WalletOpened() || asset.IsWatchingOnlyWallet() {
+		return false
+	}
+	_, err := asset.replacementTx(txHash)
+	return err == nil
+}
+
+// EstimateBumpFee returns the fee a replacement of txHash paying
+// newFeeRatePerkvB (in Lit/kvB) would pay.
+func (asset *Asset) EstimateBumpFee(txHash string, newFeeRatePerkvB int64) (*sharedW.Amount, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrLTCNotInitialized
+	}
+
+	replacement, err := asset.replacementTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := replacement.bumpFee(ltcutil.Amount(newFeeRatePerkvB))
+	if err != nil {
+		return nil, err
+	}
+	return &sharedW.Amount{UnitValue: int64(fee), CoinValue: fee.ToBTC()}, nil
+}
+
+// BumpFee replaces the unmined transaction txHash with one paying
+// newFeeRatePerkvB (in Lit/kvB), as described in BIP-125. The replacement
+// spends the same inputs and pays the same recipients; the extra fee is taken
+// from the change output. Returns the hash of the replacement transaction.
+func (asset *Asset) BumpFee(txHash string, newFeeRatePerkvB int64, privatePassphrase string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrLTCNotInitialized
+	}
+	if asset.IsWatchingOnlyWallet() {
+		return "", errors.New(utils.ErrWalletIsWatchOnly)
+	}
+
+	replacement, err := asset.replacementTx(txHash)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = replacement.bumpFee(ltcutil.Amount(newFeeRatePerkvB)); err != nil {
+		return "", err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+
+	err = asset.Internal().LTC.Unlock([]byte(privatePassphrase), lock)
+	if err != nil {
+		log.Errorf("unlocking the wallet failed: %v", err)
+		return "", errors.New(utils.ErrInvalidPassphrase)
+	}
+
+	msgTx := replacement.tx
+	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
+	if err = asset.signTransaction(msgTx); err != nil {
+		return "", err
+	}
+
+	err = asset.Internal().LTC.PublishTransaction(msgTx, replacement.label)
+	if err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	newHash := msgTx.TxHash()
+	log.Infof("Replaced tx %s with %s", txHash, newHash)
+	return newHash.String(), nil
+}
+
+// replacement is an unsigned copy of a transaction that is about to be
+// replaced with a higher fee one.
+type replacement struct {
+	tx          *wire.MsgTx
+	changeIndex int
+	label       string
+	oldFee      ltcutil.Amount
+}
+
+// replacementTx returns an unsigned copy of the unmined transaction txHash.
+func (asset *Asset) replacementTx(txHash string) (*replacement, error) {
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+
+	txResult, err := asset.Internal().LTC.GetTransaction(*hash)
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+	if txResult.BlockHash != nil {
+		return nil, errors.E(errors.Invalid, "transaction is already mined")
+	}
+
+	msgTx := new(wire.MsgTx)
+	if err = msgTx.Deserialize(bytes.NewReader(txResult.Summary.Transaction)); err != nil {
+		return nil, err
+	}
+
+	replaceable := false
+	for _, txIn := range msgTx.TxIn {
+		if txIn.Sequence < wire.MaxTxInSequenceNum-1 {
+			replaceable = true
+			break
+		}
+	}
+	if !replaceable {
+		return nil, errors.E(errors.Invalid, "transaction does not signal replaceability")
+	}
+
+	summary := txResult.Summary
+	if len(summary.MyInputs) != len(msgTx.TxIn) {
+		return nil, errors.E(errors.Invalid, "transaction spends inputs not controlled by this wallet")
+	}
+
+	changeIndex := -1
+	for _, output := range summary.MyOutputs {
+		if output.Internal {
+			changeIndex = int(output.Index)
+			break
+		}
+	}
+	if changeIndex < 0 {
+		return nil, errors.E(errors.Invalid, "transaction has no change output to take the fee from")
+	}
+
+	for _, txIn := range msgTx.TxIn {
+		txIn.SignatureScript = nil
+		txIn.Witness = nil
+		txIn.Sequence = rbfSequence
+	}
+
+	return &replacement{
+		tx:          msgTx,
+		changeIndex: changeIndex,
+		label:       summary.Label,
+		oldFee:      summary.Fee,
+	}, nil
+}
+
+// bumpFee deducts the extra fee needed for the replacement to pay
+// feeRatePerkvB from its change output. Returns the new fee.
+func (r *replacement) bumpFee(feeRatePerkvB ltcutil.Amount) (ltcutil.Amount, error) {
+	if feeRatePerkvB < MinFeeRatePerkvB {
+		return 0, fmt.Errorf("minimum rate is %d Lit/kvB", int64(MinFeeRatePerkvB))
+	}
+
+	// All inputs are spent from the BIP-84 account.
+	vSize := txsizes.EstimateVirtualSize(0, 0, len(r.tx.TxIn), 0, r.tx.TxOut, 0)
+	newFee := txrules.FeeForSerializeSize(feeRatePerkvB, vSize)
+
+	// The replacement must also pay for its own relay bandwidth on top of
+	// the fee paid by the transaction it replaces.
+	minFee := r.oldFee + txrules.FeeForSerializeSize(txrules.DefaultRelayFeePerKb, vSize)
+	if newFee < minFee {
+		return 0, errors.E(errors.Invalid, fmt.Sprintf("fee rate too low, the replacement must pay at least %v", minFee))
+	}
+
+	change := r.tx.TxOut[r.changeIndex]
+	change.Value -= int64(newFee - r.oldFee)
+	if change.Value <= 0 || txrules.IsDustOutput(change, txrules.DefaultRelayFeePerKb) {
+		return 0, errors.New(utils.ErrInsufficientBalance)
+	}
+
+	return newFee, nil
+}
@@ -285,6 +285,8 @@ func (asset *Asset) rescanFinished(height int32) {
 	asset.syncData.isRescan = false
 	asset.syncData.mu.Unlock()
 
+	go asset.pruneFrozenOutputs()
+
 	if asset.blocksRescanProgressListener != nil {
 		asset.blocksRescanProgressListener.OnBlocksRescanEnded(asset.ID, nil)
 	}
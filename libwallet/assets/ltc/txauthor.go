@@ -289,15 +289,43 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	// https://bitcoin.stackexchange.com/questions/48384/why-bitcoin-core-creates-time-locked-transactions-by-default
 	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
 
+	if err = asset.signTransaction(msgTx); err != nil {
+		return "", err
+	}
+
+	var serializedTransaction bytes.Buffer
+	serializedTransaction.Grow(msgTx.SerializeSize())
+	err = msgTx.Serialize(&serializedTransaction)
+	if err != nil {
+		log.Errorf("encoding the tx to test its validity failed: %v", err)
+		return "", err
+	}
+
+	err = msgTx.Deserialize(bytes.NewReader(serializedTransaction.Bytes()))
+	if err != nil {
+		// Invalid tx
+		log.Errorf("decoding the tx to test its validity failed: %v", err)
+		return "", err
+	}
+
+	err = asset.Internal().LTC.PublishTransaction(msgTx, transactionLabel)
+	txHash := msgTx.TxHash()
+	return txHash.String(), utils.TranslateError(err)
+}
+
+// signTransaction signs every input of msgTx, all of which must spend outputs
+// controlled by the wallet, and validates the resulting scripts. The wallet
+// must be unlocked.
+func (asset *Asset) signTransaction(msgTx *wire.MsgTx) error {
 	for index, txIn := range msgTx.TxIn {
 		_, previousTXout, _, _, err := asset.Internal().LTC.FetchInputInfo(&txIn.PreviousOutPoint)
 		if err != nil {
 			log.Errorf("fetch previous outpoint txout failed: %v", err)
-			return "", err
+			return err
 		}
 
-		prevOutScript := unsignedTx.PrevScripts[index]
-		prevOutAmount := int64(asset.TxAuthoredInfo.inputValues[index])
+		prevOutScript := previousTXout.PkScript
+		prevOutAmount := previousTXout.Value
 		prevOutFetcher := txscript.NewCannedPrevOutputFetcher(prevOutScript, prevOutAmount)
 		sigHashes := txscript.NewTxSigHashes(msgTx, prevOutFetcher)
 
@@ -306,7 +334,7 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 		)
 		if err != nil {
 			log.Errorf("generating input signatures failed: %v", err)
-			return "", err
+			return err
 		}
 
 		msgTx.TxIn[index].Witness = witness
@@ -320,32 +348,14 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 			prevOutAmount, prevOutFetcher)
 		if err != nil {
 			log.Errorf("creating validation engine failed: %v", err)
-			return "", err
+			return err
 		}
 		if err := vm.Execute(); err != nil {
 			log.Errorf("executing the validation engine failed: %v", err)
-			return "", err
+			return err
 		}
 	}
-
-	var serializedTransaction bytes.Buffer
-	serializedTransaction.Grow(msgTx.SerializeSize())
-	err = msgTx.Serialize(&serializedTransaction)
-	if err != nil {
-		log.Errorf("encoding the tx to test its validity failed: %v", err)
-		return "", err
-	}
-
-	err = msgTx.Deserialize(bytes.NewReader(serializedTransaction.Bytes()))
-	if err != nil {
-		// Invalid tx
-		log.Errorf("decoding the tx to test its validity failed: %v", err)
-		return "", err
-	}
-
-	err = asset.Internal().LTC.PublishTransaction(msgTx, transactionLabel)
-	txHash := msgTx.TxHash()
-	return txHash.String(), utils.TranslateError(err)
+	return nil
 }
 
 func (asset *Asset) unsignedTransaction() (*txauthor.AuthoredTx, error) {
@@ -425,6 +435,8 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Frozen outputs are only spent when selected manually.
+		unspents = sharedW.UnfrozenOutputs(unspents)
 	}
 
 	inputSource := asset.makeInputSource(unspents, sendMax)
@@ -564,7 +576,10 @@ func (asset *Asset) makeInputSource(outputs []*sharedW.UnspentOutput, sendMax bo
 		totalInputValue += ltcutil.Amount(output.Amount.(Amount))
 		pkScripts = append(pkScripts, script)
 		inputValues = append(inputValues, ltcutil.Amount(output.Amount.(Amount)))
-		inputs = append(inputs, wire.NewTxIn(previousOutPoint, nil, nil))
+		txIn := wire.NewTxIn(previousOutPoint, nil, nil)
+		// Signal BIP-125 replaceability so the fee can be bumped later.
+		txIn.Sequence = rbfSequence
+		inputs = append(inputs, txIn)
 	}
 
 	if sourceErr == nil && totalInputValue == 0 {
@@ -76,6 +76,9 @@ type Asset interface {
 	GetAccountBalance(accountNumber int32) (*Balance, error)
 	GetWalletBalance() (*Balance, error)
 	UnspentOutputs(account int32) ([]*UnspentOutput, error)
+	FreezeOutput(txHash string, index uint32) error
+	UnfreezeOutput(txHash string, index uint32) error
+	IsOutputFrozen(txHash string, index uint32) bool
 
 	AddSyncProgressListener(syncProgressListener *SyncProgressListener, uniqueIdentifier string) error
 	RemoveSyncProgressListener(uniqueIdentifier string)
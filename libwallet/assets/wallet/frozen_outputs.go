@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FreezeOutput excludes the unspent output txHash:index from automatic coin
+// selection until UnfreezeOutput is called for it. The list is kept in the
+// wallet data database, so it persists across sessions.
+func (wallet *Wallet) FreezeOutput(txHash string, index uint32) error {
+	return wallet.walletDataDB.FreezeOutput(outPointString(txHash, index))
+}
+
+// UnfreezeOutput makes the unspent output txHash:index available to automatic
+// coin selection again.
+func (wallet *Wallet) UnfreezeOutput(txHash string, index uint32) error {
+	return wallet.walletDataDB.UnfreezeOutput(outPointString(txHash, index))
+}
+
+// IsOutputFrozen returns true if the unspent output txHash:index is excluded
+// from automatic coin selection.
+func (wallet *Wallet) IsOutputFrozen(txHash string, index uint32) bool {
+	frozen, err := wallet.walletDataDB.FrozenOutputs()
+	if err != nil {
+		log.Errorf("reading frozen outputs failed: %v", err)
+		return false
+	}
+	return frozen[outPointString(txHash, index)]
+}
+
+// FrozenOutPoints calls fn with the transaction hash and output index of each
+// frozen output.
+func (wallet *Wallet) FrozenOutPoints(fn func(txHash string, index uint32)) error {
+	frozen, err := wallet.walletDataDB.FrozenOutputs()
+	if err != nil {
+		return err
+	}
+	for outPoint := range frozen {
+		txHash, index, ok := parseOutPoint(outPoint)
+		if !ok {
+			log.Errorf("invalid frozen output %s", outPoint)
+			continue
+		}
+		fn(txHash, index)
+	}
+	return nil
+}
+
+// MarkFrozenOutputs sets the Frozen field of each of utxos.
+func (wallet *Wallet) MarkFrozenOutputs(utxos []*UnspentOutput) error {
+	frozen, err := wallet.walletDataDB.FrozenOutputs()
+	if err != nil {
+		return err
+	}
+	for _, utxo := range utxos {
+		utxo.Frozen = frozen[outPointString(utxo.TxID, utxo.Vout)]
+	}
+	return nil
+}
+
+// FrozenAmount returns the total value of the frozen outputs among the unspent
+// outputs returned by listUnspent, which isn't called if no output is frozen.
+// It's deducted from the spendable balance since only manual coin selection
+// can spend frozen outputs.
+func (wallet *Wallet) FrozenAmount(listUnspent func() ([]*UnspentOutput, error)) (int64, error) {
+	frozen, err := wallet.walletDataDB.FrozenOutputs()
+	if err != nil || len(frozen) == 0 {
+		return 0, err
+	}
+
+	utxos, err := listUnspent()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, utxo := range utxos {
+		if frozen[outPointString(utxo.TxID, utxo.Vout)] {
+			total += utxo.Amount.ToInt()
+		}
+	}
+	return total, nil
+}
+
+// PruneFrozenOutputs removes the outputs that were spent from the frozen
+// outputs, using the unspent outputs of the accounts listed by getAccounts.
+// unfreeze is called for each of them. It must only be called once the wallet
+// is synced, when the unspent outputs of the accounts are complete.
+func (wallet *Wallet) PruneFrozenOutputs(getAccounts func() (*Accounts, error),
+	listUnspent func(account int32) ([]*UnspentOutput, error), unfreeze func(txHash string, index uint32) error,
+) error {
+	frozen, err := wallet.walletDataDB.FrozenOutputs()
+	if err != nil || len(frozen) == 0 {
+		return err
+	}
+
+	accounts, err := getAccounts()
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts.Accounts {
+		utxos, err := listUnspent(account.Number)
+		if err != nil {
+			return err
+		}
+		for _, utxo := range utxos {
+			delete(frozen, outPointString(utxo.TxID, utxo.Vout))
+		}
+	}
+
+	// The outputs left weren't listed, they were spent.
+	for outPoint := range frozen {
+		txHash, index, ok := parseOutPoint(outPoint)
+		if !ok {
+			continue
+		}
+		if err := unfreeze(txHash, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnfrozenOutputs returns the utxos that aren't frozen, i.e. those automatic
+// coin selection may spend.
+func UnfrozenOutputs(utxos []*UnspentOutput) []*UnspentOutput {
+	unfrozen := make([]*UnspentOutput, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.Frozen {
+			unfrozen = append(unfrozen, utxo)
+		}
+	}
+	return unfrozen
+}
+
+func outPointString(txHash string, index uint32) string {
+	return fmt.Sprintf("%s:%d", txHash, index)
+}
+
+func parseOutPoint(outPoint string) (string, uint32, bool) {
+	i := strings.LastIndex(outPoint, ":")
+	if i < 0 {
+		return "", 0, false
+	}
+	index, err := strconv.ParseUint(outPoint[i+1:], 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+	return outPoint[:i], uint32(index), true
+}
@@ -89,6 +89,23 @@ type TxFeeAndSize struct {
 	EstimatedSignedSize int
 }
 
+// CPFPInfo describes a child-pays-for-parent transaction that spends the
+// unconfirmed outputs of a parent transaction back to the wallet, paying
+// enough fee for both to reach a target rate. Sizes are in vbytes and rates in
+// Sat/kvB or Lit/kvB. ParentFee is zero when unknown, which is the case for
+// parents funded by someone else.
+type CPFPInfo struct {
+	ParentHash     string
+	ParentSize     int
+	ParentFee      *Amount
+	ParentFeeKnown bool
+	ChildSize      int
+	ChildFee       *Amount
+	ChildAmount    *Amount
+	TargetFeeRate  int64
+	PackageFeeRate int64
+}
+
 type UnsignedTransaction struct {
 	UnsignedTransaction       []byte
 	EstimatedSignedSize       int
@@ -420,6 +437,9 @@ type UnspentOutput struct {
 	Spendable     bool
 	ReceiveTime   time.Time
 	Tree          int8
+	// Frozen is true if the output is excluded from automatic coin
+	// selection.
+	Frozen bool
 }
 
 type WordSeedType int
@@ -11,6 +11,7 @@ const (
 	AccountMixerMixedAccount   = "account_mixer_mixed_account"
 	AccountMixerUnmixedAccount = "account_mixer_unmixed_account"
 	AccountMixerMixTxChange    = "account_mixer_mix_tx_change"
+	AccountMixerWindows        = "account_mixer_windows"
 
 	walletsMetadataBucketName = "metadata" // Wallet level bucket.
 
@@ -37,10 +38,24 @@ const (
 
 	KnownVSPsConfigKey = "known_vsps"
 
-	TicketBuyerVSPHostConfigKey = "tb_vsp_host"
-	TicketBuyerWalletConfigKey  = "tb_wallet_id"
-	TicketBuyerAccountConfigKey = "tb_account_number"
-	TicketBuyerATMConfigKey     = "tb_amount_to_maintain"
+	SoloVotingNodeConfigKey = "solo_voting_node"
+
+	TicketBuyerVSPHostConfigKey   = "tb_vsp_host"
+	TicketBuyerVSPHostsConfigKey  = "tb_vsp_hosts"
+	TicketBuyerMaxVSPFeeConfigKey = "tb_max_vsp_fee"
+	TicketBuyerWalletConfigKey    = "tb_wallet_id"
+	TicketBuyerAccountConfigKey   = "tb_account_number"
+	TicketBuyerATMConfigKey       = "tb_amount_to_maintain"
+
+	TicketBuyerMaxTicketPriceConfigKey      = "tb_max_ticket_price"
+	TicketBuyerMaxTicketsPerDayConfigKey    = "tb_max_tickets_per_day"
+	TicketBuyerMaxTicketsPerWindowConfigKey = "tb_max_tickets_per_window"
+	TicketBuyerActiveFromHourConfigKey      = "tb_active_from_hour"
+	TicketBuyerActiveToHourConfigKey        = "tb_active_to_hour"
+	TicketBuyerPurchasesConfigKey           = "tb_purchases"
+
+	TicketBuyerWasActiveConfigKey        = "tb_was_active"
+	TicketBuyerResumePassphraseConfigKey = "tb_resume_passphrase"
 
 	ExchangeSourceDstnTypeConfigKey = "exchange_source_destination_key"
 
@@ -51,6 +66,7 @@ const (
 	ProposalNotificationConfigKey    = "proposal_notification_key"
 	TransactionNotificationConfigKey = "transaction_notification_key"
 	SpendUnmixedFundsKey             = "spend_unmixed_funds"
+	StrictPrivacySendConfigKey       = "strict_privacy_send"
 	LanguagePreferenceKey            = "app_language"
 	DarkModeConfigKey                = "dark_mode"
 	HideTotalBalanceConfigKey        = "hideTotalUSDBalance"
@@ -184,6 +184,17 @@ func decryptWalletMnemonic(pass []byte, encryptedMnemonic []byte) (string, error
 	return string(decryptedMnemonic), nil
 }
 
+// EncryptWithPassphrase encrypts secret with pass the same way wallet seeds
+// are encrypted, so it can be stored in the wallet's config.
+func EncryptWithPassphrase(pass []byte, secret string) ([]byte, error) {
+	return encryptWalletMnemonic(pass, secret)
+}
+
+// DecryptWithPassphrase decrypts a secret encrypted with EncryptWithPassphrase.
+func DecryptWithPassphrase(pass []byte, encrypted []byte) (string, error) {
+	return decryptWalletMnemonic(pass, encrypted)
+}
+
 // For use with gomobile bind,
 // doesn't support the alternative `GenerateSeed` function because it returns more than 2 types.
 func generateMnemonic(wordSeedType WordSeedType) (v string, err error) {
@@ -0,0 +1,42 @@
+package walletdata
+
+import (
+	"github.com/asdine/storm"
+)
+
+// FrozenOutput is an unspent output the user excluded from automatic coin
+// selection. It's kept in a bucket of its own so it survives the transaction
+// index being dropped and rebuilt.
+type FrozenOutput struct {
+	OutPoint string `storm:"id"`
+}
+
+// FreezeOutput adds outPoint to the frozen outputs.
+func (db *DB) FreezeOutput(outPoint string) error {
+	return db.walletDataDB.Save(&FrozenOutput{OutPoint: outPoint})
+}
+
+// UnfreezeOutput removes outPoint from the frozen outputs. It's not an error
+// if outPoint isn't frozen.
+func (db *DB) UnfreezeOutput(outPoint string) error {
+	err := db.walletDataDB.DeleteStruct(&FrozenOutput{OutPoint: outPoint})
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+// FrozenOutputs returns the set of frozen outpoints.
+func (db *DB) FrozenOutputs() (map[string]bool, error) {
+	var outputs []FrozenOutput
+	err := db.walletDataDB.All(&outputs)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, err
+	}
+
+	frozen := make(map[string]bool, len(outputs))
+	for _, output := range outputs {
+		frozen[output.OutPoint] = true
+	}
+	return frozen, nil
+}
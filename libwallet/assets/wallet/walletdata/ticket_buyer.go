@@ -0,0 +1,40 @@
+package walletdata
+
+import (
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/index"
+)
+
+// TicketBuyerSession is a run of the automatic ticket buyer, from the time it
+// was started to the time it stopped. Times are unix timestamps.
+type TicketBuyerSession struct {
+	ID          int `storm:"id,increment"`
+	Start       int64
+	StartReason string
+	// Stop is zero while the session is running, or if the app exited
+	// without stopping it.
+	Stop       int64
+	StopReason string
+}
+
+// SaveTicketBuyerSession adds session to the ticket buyer history, or updates
+// it if it was saved before.
+func (db *DB) SaveTicketBuyerSession(session *TicketBuyerSession) error {
+	return db.walletDataDB.Save(session)
+}
+
+// TicketBuyerSessions returns up to limit ticket buyer sessions, newest first.
+// All the sessions are returned if limit is zero.
+func (db *DB) TicketBuyerSessions(limit int) ([]*TicketBuyerSession, error) {
+	options := []func(*index.Options){storm.Reverse()}
+	if limit > 0 {
+		options = append(options, storm.Limit(limit))
+	}
+
+	var sessions []*TicketBuyerSession
+	err := db.walletDataDB.All(&sessions, options...)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, err
+	}
+	return sessions, nil
+}
@@ -20,6 +20,9 @@ import (
 const (
 	logFileName   = "libwallet.log"
 	walletsDbName = "wallets.db"
+	// addressBookFileName is the name of the address book file in the app's
+	// root dir, outside the network folders.
+	addressBookFileName = "addressbook.json"
 
 	// Mainnet represents the main network.
 	Mainnet = utils.Mainnet
@@ -116,6 +119,12 @@ func (mgr *AssetsManager) ChangeStartupPassphrase(oldPassphrase, newPassphrase s
 	mgr.SaveAppConfigValue(walletStartupPassphraseField, startupPassphraseHash)
 	mgr.SaveAppConfigValue(sharedW.IsStartupSecuritySetConfigKey, true)
 	mgr.SaveAppConfigValue(sharedW.StartupSecurityTypeConfigKey, passphraseType)
+
+	// A PIN is too weak to keep the ticket buyers' passphrases safe.
+	if passphraseType == sharedW.PassphraseTypePin {
+		newPassphrase = ""
+	}
+	mgr.rekeyTicketBuyerResume(oldPassphrase, newPassphrase)
 	return nil
 }
 
@@ -129,6 +138,7 @@ func (mgr *AssetsManager) RemoveStartupPassphrase(oldPassphrase string) error {
 	mgr.appConfigDelete(walletStartupPassphraseField)
 	mgr.SaveAppConfigValue(sharedW.IsStartupSecuritySetConfigKey, false)
 	mgr.appConfigDelete(sharedW.StartupSecurityTypeConfigKey)
+	mgr.rekeyTicketBuyerResume(oldPassphrase, "")
 
 	return nil
 }
@@ -140,6 +150,14 @@ func (mgr *AssetsManager) IsStartupSecuritySet() bool {
 	return data
 }
 
+// StartupSecurityType returns whether the startup passphrase is a PIN or a
+// password, see sharedW.PassphraseTypePin and sharedW.PassphraseTypePass.
+func (mgr *AssetsManager) StartupSecurityType() int32 {
+	passphraseType := sharedW.PassphraseTypePass
+	mgr.ReadAppConfigValue(sharedW.StartupSecurityTypeConfigKey, &passphraseType)
+	return passphraseType
+}
+
 // IsDarkModeOn checks if the dark mode is set.
 func (mgr *AssetsManager) IsDarkModeOn() bool {
 	var data bool
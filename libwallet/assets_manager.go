@@ -26,6 +26,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui/values"
 	bolt "go.etcd.io/bbolt"
 
+	"github.com/crypto-power/cryptopower/libwallet/addressbook"
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
@@ -72,6 +73,7 @@ type AssetsManager struct {
 	ConsensusAgenda *dcr.ConsensusAgenda
 	Politeia        *politeia.Politeia
 	InstantSwap     *instantswap.InstantSwap
+	AddressBook     *addressbook.AddressBook
 	ExternalService *ext.Service
 	RateSource      ext.RateSource
 	rateMutex       sync.Mutex
@@ -157,6 +159,9 @@ func NewAssetsManager(rootDir, logDir string, netType utils.NetworkType, dexTest
 		dbDriver = BadgerDB
 	}
 
+	// The address book is shared by the networks, see addressbook.AddressBook.
+	addressBookPath := filepath.Join(rootDir, addressBookFileName)
+
 	if fileExists(filepath.Join(rootDir, fmt.Sprintf("%s-%s", string(netType), dbDriver))) {
 		// New db
 		rootDir = filepath.Join(rootDir, fmt.Sprintf("%s-%s", string(netType), dbDriver))
@@ -218,11 +223,18 @@ func NewAssetsManager(rootDir, logDir string, netType utils.NetworkType, dexTest
 		return nil, err
 	}
 
+	addressBook, err := addressbook.New(addressBookPath)
+	if err != nil {
+		log.Errorf("Error initializing address book: %s", err.Error())
+		return nil, err
+	}
+
 	mgr.ConsensusAgenda = dcr.NewConsensusAgenda(mgr.chainsParams.DCR, mwDB)
 
 	mgr.params.DB = mwDB
 	mgr.Politeia = politeia
 	mgr.InstantSwap = instantSwap
+	mgr.AddressBook = addressBook
 
 	// initialize the ExternalService. ExternalService provides assetsManager
 	// with the functionalities to retrieve data from some 3rd party services.
@@ -477,6 +489,7 @@ func (mgr *AssetsManager) OpenWallets(startupPassphrase string) error {
 		}
 	}
 
+	mgr.resumeTicketBuyers(startupPassphrase)
 	return nil
 }
 
@@ -86,12 +86,12 @@ func (mgr *AssetsManager) BTCWalletWithXPub(xpub string) (int, error) {
 			if accs.AccountNumber == btc.ImportedAccountNumber {
 				continue
 			}
-			acctXPubKey, err := wallet.Internal().BTC.AccountProperties(btc.GetScope(), accs.AccountNumber)
+			acctXPub, err := wallet.GetExtendedPubKey(accs.Number)
 			if err != nil {
 				return -1, err
 			}
 
-			if acctXPubKey.AccountPubKey.String() == xpub {
+			if acctXPub == xpub {
 				return wallet.GetWalletID(), nil
 			}
 		}
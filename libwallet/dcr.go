@@ -200,3 +200,51 @@ func deriveBIP44AccountXPubsForDCR(seedMnemonic string, wordSeedType sharedW.Wor
 
 	return legacyXPUb, slip0044XPUb, nil
 }
+
+// EnableTicketBuyerResume makes the ticket buyer of the DCR wallet with the
+// provided ID restart after the app is unlocked if it was running when the
+// app exited. It requires the startup passphrase to be set, since that's what
+// the wallet passphrase is stored encrypted with, and to be a password: a PIN
+// is too easily guessed to protect the wallet passphrase.
+func (mgr *AssetsManager) EnableTicketBuyerResume(walletID int, passphrase, startupPassphrase string) error {
+	if !mgr.IsStartupSecuritySet() {
+		return errors.E(errors.Invalid, "the startup passphrase must be set to resume the ticket buyer")
+	}
+	if mgr.StartupSecurityType() != sharedW.PassphraseTypePass {
+		return errors.E(errors.Invalid, "a startup password, not a PIN, is required to resume the ticket buyer")
+	}
+	if err := mgr.VerifyStartupPassphrase(startupPassphrase); err != nil {
+		return err
+	}
+
+	asset, ok := mgr.Assets.DCR.Wallets[walletID].(*dcr.Asset)
+	if !ok {
+		return errors.New(utils.ErrNotExist)
+	}
+	return asset.EnableTicketBuyerResume(passphrase, startupPassphrase)
+}
+
+// resumeTicketBuyers restarts the ticket buyers that were running when the
+// app exited, see dcr.Asset.ResumeTicketBuyer.
+func (mgr *AssetsManager) resumeTicketBuyers(startupPassphrase string) {
+	for _, wallet := range mgr.Assets.DCR.Wallets {
+		asset, ok := wallet.(*dcr.Asset)
+		if !ok {
+			continue
+		}
+		if err := asset.ResumeTicketBuyer(startupPassphrase); err != nil {
+			log.Errorf("[%d] Unable to resume the ticket buyer: %v", asset.ID, err)
+		}
+	}
+}
+
+// rekeyTicketBuyerResume encrypts the passphrases the ticket buyers resume
+// with using the new startup passphrase, or disables resuming if the startup
+// passphrase is removed.
+func (mgr *AssetsManager) rekeyTicketBuyerResume(oldStartupPassphrase, newStartupPassphrase string) {
+	for _, wallet := range mgr.Assets.DCR.Wallets {
+		if asset, ok := wallet.(*dcr.Asset); ok {
+			asset.RekeyTicketBuyerResume(oldStartupPassphrase, newStartupPassphrase)
+		}
+	}
+}
@@ -28,6 +28,11 @@ const (
 
 	// MktSep is used repo wide to separate market symbols.
 	MktSep = "-"
+
+	// historicalRatesBatch is the number of hourly rates requested at once
+	// when a historical rate isn't cached. It's the maximum allowed by
+	// Binance and covers about 41 days.
+	historicalRatesBatch = 1000
 )
 
 var (
@@ -42,11 +47,15 @@ var (
 	binanceURLs = sourceURLs{
 		// See: https://binance-docs.github.io/apidocs/spot/en/#current-average-price
 		price: "https://api.binance.com/api/v3/ticker/24hr?symbol=%s",
+		// See: https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-data
+		history: "https://api.binance.com/api/v3/klines?symbol=%s&interval=1h&startTime=%d&limit=%d",
 	}
 
 	binanceUSURLs = sourceURLs{
 		// See: https://binance-docs.github.io/apidocs/spot/en/#current-average-price
 		price: "https://api.binance.us/api/v3/ticker/24hr?symbol=%s",
+		// See: https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-data
+		history: "https://api.binance.us/api/v3/klines?symbol=%s&interval=1h&startTime=%d&limit=%d",
 	}
 
 	// According to the docs (See:
@@ -109,6 +118,11 @@ var (
 	// Rate sources should be refreshed every RateRefreshDuration to replace
 	// expired rates and reconnect websocket if need be.
 	RateRefreshDuration = 60 * time.Minute
+
+	// ErrHistoricalRateUnavailable is returned by HistoricalRate if the rate
+	// source doesn't provide historical rates or has no rate for the
+	// requested time.
+	ErrHistoricalRateUnavailable = errors.New("historical rate unavailable")
 )
 
 // RateSource is the interface that binds different rate sources. Most of the
@@ -121,6 +135,7 @@ type RateSource interface {
 	Refreshing() bool
 	LastUpdate() time.Time
 	GetTicker(market values.Market, cacheOnly bool) *Ticker
+	HistoricalRate(market values.Market, at time.Time) (float64, error)
 	ToggleStatus(disable bool)
 	ToggleSource(newSource string) error
 	AddRateListener(listener *RateListener, uniqueIdentifier string) error
@@ -153,6 +168,7 @@ type CommonRateSource struct {
 	disabled                  bool
 	mtx                       sync.RWMutex
 	tickers                   map[values.Market]*Ticker
+	historicalRates           map[values.Market]map[int64]float64
 	refreshing                bool
 	cond                      *sync.Cond
 	getTicker                 tickerFunc
@@ -175,6 +191,7 @@ func NewCommonRateSource(ctx context.Context, source string, disableConversionEx
 		ctx:                       ctx,
 		source:                    source,
 		tickers:                   make(map[values.Market]*Ticker),
+		historicalRates:           make(map[values.Market]map[int64]float64),
 		sourceChanged:             make(chan *struct{}),
 		disableConversionExchange: disableConversionExchange,
 		ratesListeners:            make(map[string]*RateListener),
@@ -310,6 +327,7 @@ func (cs *CommonRateSource) ToggleSource(newSource string) error {
 	cs.source = newSource
 	cs.getTicker = getTickerFn
 	cs.tickers = make(map[values.Market]*Ticker)
+	cs.historicalRates = make(map[values.Market]map[int64]float64)
 	cs.mtx.Unlock()
 
 	if refresh {
@@ -421,6 +439,114 @@ func (cs *CommonRateSource) GetTicker(market values.Market, cacheOnly bool) *Tic
 	return &t
 }
 
+// HistoricalRate returns the opening price of market for the hour that
+// includes at. Only the Binance rate sources provide historical rates, other
+// sources return ErrHistoricalRateUnavailable. Rates are cached, and a missing
+// rate is fetched together with the rates of the hours that follow it, so
+// looking up the rates of transactions sorted by time takes few requests.
+func (cs *CommonRateSource) HistoricalRate(market values.Market, at time.Time) (float64, error) {
+	marketName, ok := isSupportedMarket(market, cs.source)
+	if !ok || cs.isDisabled() {
+		return 0, ErrHistoricalRateUnavailable
+	}
+
+	hour := at.Truncate(time.Hour).Unix()
+	cs.mtx.RLock()
+	source := cs.source
+	rate, cached := cs.historicalRates[marketName][hour]
+	cs.mtx.RUnlock()
+
+	if !cached {
+		if source != binance && source != binanceUS {
+			return 0, ErrHistoricalRateUnavailable
+		}
+
+		rates, err := binanceHistoricalRates(source, marketName, hour)
+		if err != nil {
+			return 0, err
+		}
+		// Remember hours without a rate, e.g. before the market was listed,
+		// so they aren't requested again.
+		rate = rates[hour]
+		rates[hour] = rate
+
+		cs.mtx.Lock()
+		if cs.historicalRates[marketName] == nil {
+			cs.historicalRates[marketName] = make(map[int64]float64)
+		}
+		for h, r := range rates {
+			cs.historicalRates[marketName][h] = r
+		}
+		cs.mtx.Unlock()
+	}
+
+	if rate == 0 {
+		return 0, ErrHistoricalRateUnavailable
+	}
+	return rate, nil
+}
+
+// HistoricalRateLookup returns a function that looks up the rates of market
+// with rateSource. Once a lookup fails for another reason than the rate being
+// unavailable, e.g. because the rate source can't be reached, the error is
+// logged and returned for every further lookup without requesting more rates.
+func HistoricalRateLookup(rateSource RateSource, market values.Market) func(time.Time) (float64, error) {
+	var lookupErr error
+	return func(at time.Time) (float64, error) {
+		if lookupErr != nil {
+			return 0, lookupErr
+		}
+		rate, err := rateSource.HistoricalRate(market, at)
+		if err != nil && err != ErrHistoricalRateUnavailable {
+			log.Errorf("%s historical rate lookup failed: %v", market, err)
+			lookupErr = err
+		}
+		return rate, err
+	}
+}
+
+// binanceHistoricalRates fetches the hourly opening prices of market starting
+// at the hour with the unix timestamp from. The returned map is keyed by the
+// unix timestamp of each hour.
+func binanceHistoricalRates(source string, market values.Market, from int64) (map[int64]float64, error) {
+	urlFormat := binanceURLs.history
+	if source == binanceUS {
+		urlFormat = binanceUSURLs.history
+	}
+	reqCfg := &utils.ReqConfig{
+		HTTPURL: fmt.Sprintf(urlFormat, market.MarketWithoutSep(), from*1000, historicalRatesBatch),
+		Method:  "GET",
+	}
+
+	// Each kline is an array of mixed values, the first two being the open
+	// time in milliseconds and the opening price as a string.
+	var klines [][]interface{}
+	if _, err := utils.HTTPRequest(reqCfg, &klines); err != nil {
+		return nil, fmt.Errorf("%s failed to fetch historical rates for %s: %w", source, market, err)
+	}
+
+	rates := make(map[int64]float64, len(klines))
+	for _, kline := range klines {
+		if len(kline) < 2 {
+			continue
+		}
+		openTime, ok := kline[0].(float64)
+		if !ok {
+			continue
+		}
+		openPrice, ok := kline[1].(string)
+		if !ok {
+			continue
+		}
+		price, err := strconv.ParseFloat(openPrice, 64)
+		if err != nil {
+			continue
+		}
+		rates[int64(openTime)/1000] = price
+	}
+	return rates, nil
+}
+
 // fetchRate retrieves new ticker information via the rate source's HTTP API.
 func (cs *CommonRateSource) fetchRate(market values.Market) *Ticker {
 	newTicker, err := cs.retryGetTicker(market)
@@ -162,6 +162,18 @@ func (s *Service) GetTreasuryDetails() (treasuryDetails *TreasuryDetails, err er
 	return treasuryDetails, err
 }
 
+// GetTreasuryIO returns the amounts received and spent by the treasury per
+// time interval. Grouping is one of "day", "week", "month" or "year".
+func (s *Service) GetTreasuryIO(grouping string) (treasuryIO *TreasuryIO, err error) {
+	reqConf := &utils.ReqConfig{
+		Method:  http.MethodGet,
+		HTTPURL: setBackend(DcrData, s.network, "api/treasury/io/"+grouping),
+	}
+	treasuryIO = &TreasuryIO{}
+	_, err = utils.HTTPRequest(reqConf, treasuryIO)
+	return treasuryIO, err
+}
+
 // GetExchangeRate fetches exchange rate data summary.
 func (s *Service) GetExchangeRate() (rates *ExchangeRates, err error) {
 	reqConf := &utils.ReqConfig{
@@ -39,6 +39,15 @@ type (
 		Immature       int64 `json:"immature"`
 	}
 
+	// TreasuryIO is the treasury's received, spent and net amounts in DCR
+	// for each time interval, oldest first.
+	TreasuryIO struct {
+		Time     []time.Time `json:"time"`
+		Received []float64   `json:"received"`
+		Sent     []float64   `json:"sent"`
+		Net      []float64   `json:"net"`
+	}
+
 	// BaseState are the non-iterable fields of the ExchangeState, which embeds
 	// BaseState.
 	BaseState struct {
@@ -171,6 +180,6 @@ type (
 	}
 
 	sourceURLs struct {
-		price, stats string
+		price, stats, history string
 	}
 )
@@ -18,6 +18,7 @@ const (
 
 	configDBBkt                  = "politeia_config"
 	LastSyncedTimestampConfigKey = "politeia_last_synced_timestamp"
+	serverPublicKeyConfigKey     = "politeia_server_public_key"
 )
 
 type Politeia struct {
@@ -48,6 +49,22 @@ func New(host string, db *storm.DB) (*Politeia, error) {
 		log.Errorf("Error initializing politeia database: %s", err.Error())
 		return nil, err
 	}
+	if err := db.Init(&ProposalComment{}); err != nil {
+		log.Errorf("Error initializing politeia comments database: %s", err.Error())
+		return nil, err
+	}
+	if err := db.Init(&commentsSyncState{}); err != nil {
+		log.Errorf("Error initializing politeia comments database: %s", err.Error())
+		return nil, err
+	}
+	if err := db.Init(&ProposalVersion{}); err != nil {
+		log.Errorf("Error initializing politeia versions database: %s", err.Error())
+		return nil, err
+	}
+	if err := db.Init(&VoteReceipt{}); err != nil {
+		log.Errorf("Error initializing politeia vote receipts database: %s", err.Error())
+		return nil, err
+	}
 
 	return &Politeia{
 		host: host,
@@ -245,6 +262,16 @@ func (p *Politeia) ClearSavedProposals() error {
 		return translateError(err)
 	}
 
+	// Vote receipts are kept, unlike the rest they can't be fetched again.
+	for _, data := range []interface{}{&ProposalComment{}, &commentsSyncState{}, &ProposalVersion{}} {
+		if err := p.db.Drop(data); err != nil {
+			return translateError(err)
+		}
+		if err := p.db.Init(data); err != nil {
+			return translateError(err)
+		}
+	}
+
 	return p.db.Init(&Proposal{})
 }
 
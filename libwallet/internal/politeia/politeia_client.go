@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/crypto-power/cryptopower/libwallet/utils"
+	cmv1 "github.com/decred/politeia/politeiawww/api/comments/v1"
 	tkv1 "github.com/decred/politeia/politeiawww/api/ticketvote/v1"
 	www "github.com/decred/politeia/politeiawww/api/www/v1"
 	"github.com/decred/politeia/politeiawww/client"
@@ -20,13 +22,18 @@ type politeiaClient struct {
 }
 
 type metadataProposal struct {
-	Name   string `json:"name"`
-	LinkBy int64  `json:"linkby"`
-	LinkTo string `json:"linkto"`
+	Name      string `json:"name"`
+	Amount    uint64 `json:"amount"`    // funding amount in USD cents
+	StartDate int64  `json:"startdate"` // funding start date
+	EndDate   int64  `json:"enddate"`   // funding end date
+	Domain    string `json:"domain"`
+	LinkBy    int64  `json:"linkby"`
+	LinkTo    string `json:"linkto"`
 }
 
 const (
 	ticketVoteAPI       = tkv1.APIRoute
+	commentsAPI         = cmv1.APIRoute
 	proposalDetailsPath = "/proposals/"
 )
 
@@ -38,6 +45,7 @@ func (p *Politeia) getClient() error {
 		if err := p.client.serverVersion(); err != nil {
 			return err
 		}
+		p.pinServerPublicKey(p.client.version.PubKey)
 
 		if err := p.client.serverPolicy(); err != nil {
 			return err
@@ -113,7 +121,7 @@ func (c *politeiaClient) batchProposals(tokens []string) ([]Proposal, error) {
 
 		for _, meta := range proposalRecord.Metadata {
 			if meta.Hint == "proposalmetadata" {
-				proposal.Type = getProposalType(meta.Payload)
+				setProposalMetadata(&proposal, meta.Payload)
 				break
 			}
 		}
@@ -131,32 +139,46 @@ func (c *politeiaClient) batchProposals(tokens []string) ([]Proposal, error) {
 	return proposals, nil
 }
 
-func getProposalType(payload string) ProposalType {
-	prop := ProposalTypeNormal
+// setProposalMetadata sets the type and the funding details of the proposal
+// from its proposal metadata.
+func setProposalMetadata(proposal *Proposal, payload string) {
+	proposal.Type = ProposalTypeNormal
 	decodedBytes, err := base64.StdEncoding.DecodeString(payload)
 	if err != nil {
 		fmt.Println("error decoding proposal metadata:", err)
-		return prop
+		return
 	}
 
 	var meta metadataProposal
 	err = json.Unmarshal(decodedBytes, &meta)
 	if err != nil {
 		log.Error("error unmarshalling metadataProposal:", err)
-		return prop
+		return
 	}
 
 	if meta.LinkTo != "" {
-		prop = ProposalTypeRFPSubmission
+		proposal.Type = ProposalTypeRFPSubmission
 	} else if meta.LinkBy != 0 {
-		prop = ProposalTypeRFPProposal
+		proposal.Type = ProposalTypeRFPProposal
 	}
 
-	return prop
+	proposal.Amount = meta.Amount
+	proposal.StartDate = meta.StartDate
+	proposal.EndDate = meta.EndDate
+	proposal.Domain = meta.Domain
 }
 
 func (c *politeiaClient) proposalDetails(token string) (*www.ProposalDetailsReply, error) {
+	return c.proposalVersionDetails(token, "")
+}
+
+// proposalVersionDetails returns the provided version of a proposal, or its
+// latest version if version is empty.
+func (c *politeiaClient) proposalVersionDetails(token, version string) (*www.ProposalDetailsReply, error) {
 	route := proposalDetailsPath + token
+	if version != "" {
+		route += "?version=" + url.QueryEscape(version)
+	}
 
 	var proposalDetailsReply www.ProposalDetailsReply
 	err := c.makeRequest(http.MethodGet, apiPath, route, nil, &proposalDetailsReply)
@@ -248,22 +270,32 @@ func (c *politeiaClient) batchVoteSummary(tokens []string) (map[string]www.VoteS
 	return batchVoteSummaryReply.Summaries, nil
 }
 
-func (c *politeiaClient) sendVotes(votes []tkv1.CastVote) error {
+func (c *politeiaClient) sendVotes(votes []tkv1.CastVote) ([]tkv1.CastVoteReply, error) {
 	b, err := json.Marshal(&tkv1.CastBallot{Votes: votes})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var reply tkv1.CastBallotReply
 	err = c.makeRequest(http.MethodPost, ticketVoteAPI, tkv1.RouteCastBallot, b, &reply)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, receipt := range reply.Receipts {
-		if receipt.ErrorContext != "" {
-			return fmt.Errorf(receipt.ErrorContext)
-		}
+	return reply.Receipts, nil
+}
+
+func (c *politeiaClient) comments(token string) ([]cmv1.Comment, error) {
+	requestBody, err := json.Marshal(&cmv1.Comments{Token: token})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+
+	var commentsReply cmv1.CommentsReply
+	err = c.makeRequest(http.MethodPost, commentsAPI, cmv1.RouteComments, requestBody, &commentsReply)
+	if err != nil {
+		return nil, err
+	}
+
+	return commentsReply.Comments, nil
 }
@@ -0,0 +1,158 @@
+package politeia
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+)
+
+// GetProposalComments returns the saved comments of the proposal with the
+// provided token in thread order: each comment is followed by its replies,
+// oldest first, with its Depth set.
+func (p *Politeia) GetProposalComments(token string) ([]*ProposalComment, error) {
+	var comments []*ProposalComment
+	err := p.db.Select(q.Eq("Token", token)).Find(&comments)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, fmt.Errorf("error fetching proposal comments: %s", err.Error())
+	}
+
+	replies := make(map[uint32][]*ProposalComment)
+	for _, comment := range comments {
+		replies[comment.ParentID] = append(replies[comment.ParentID], comment)
+	}
+	for _, r := range replies {
+		sort.Slice(r, func(i, j int) bool {
+			return r[i].CommentID < r[j].CommentID
+		})
+	}
+
+	threaded := make([]*ProposalComment, 0, len(comments))
+	var addThread func(parentID uint32, depth int)
+	addThread = func(parentID uint32, depth int) {
+		for _, comment := range replies[parentID] {
+			comment.Depth = depth
+			threaded = append(threaded, comment)
+			addThread(comment.CommentID, depth+1)
+		}
+	}
+	addThread(0, 0)
+	return threaded, nil
+}
+
+// IsProposalCommentsSynced returns true if the comments of the proposal with
+// the provided token were fetched before. They are kept up to date by the
+// politeia sync from then on.
+func (p *Politeia) IsProposalCommentsSynced(token string) bool {
+	var state commentsSyncState
+	return p.db.One("Token", token, &state) == nil
+}
+
+// FetchProposalComments fetches and saves the comments of the proposal with
+// the provided token.
+func (p *Politeia) FetchProposalComments(token string) error {
+	proposal, err := p.GetProposalRaw(token)
+	if err != nil {
+		return err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	err = p.getClient()
+	if err != nil {
+		return err
+	}
+
+	return p.syncProposalComments(proposal)
+}
+
+// syncComments updates the saved comments of the proposals whose comments
+// were fetched before, see commentsNeedSync. Comments of other proposals are
+// only fetched on request, see FetchProposalComments. A proposal whose
+// comments can't be fetched is retried on the next sync.
+func (p *Politeia) syncComments() error {
+	var states []commentsSyncState
+	err := p.db.All(&states)
+	if err != nil && err != storm.ErrNotFound {
+		return err
+	}
+
+	for _, state := range states {
+		// Check if politeia has been shutdown and exit if true.
+		if p.ctx.Err() != nil {
+			return p.ctx.Err()
+		}
+
+		proposal, err := p.GetProposalRaw(state.Token)
+		if err != nil || !commentsNeedSync(proposal, &state) {
+			continue
+		}
+
+		p.mu.RLock()
+		err = p.syncProposalComments(proposal)
+		p.mu.RUnlock()
+		if err != nil {
+			log.Errorf("Error syncing comments of proposal %s: %v", proposal.Token, err)
+		}
+	}
+
+	return nil
+}
+
+// commentsNeedSync returns true if the saved comments of proposal may be out of
+// date. Comments of proposals under discussion or voting are always synced
+// since their votes change and they can be edited or censored without a change
+// in the number of comments. The comments of other proposals are only synced
+// if the number of comments changed or the proposal was updated.
+func commentsNeedSync(proposal *Proposal, state *commentsSyncState) bool {
+	switch {
+	case proposal.Category == ProposalCategoryPre, proposal.Category == ProposalCategoryActive:
+		return true
+	case proposal.NumComments != state.NumComments:
+		return true
+	default:
+		return proposal.Timestamp != state.Timestamp || proposal.PublishedAt != state.PublishedAt
+	}
+}
+
+// syncProposalComments must be called with p.mu held.
+func (p *Politeia) syncProposalComments(proposal *Proposal) error {
+	comments, err := p.client.comments(proposal.Token)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range comments {
+		comment := &ProposalComment{
+			ID:        fmt.Sprintf("%s:%d", proposal.Token, c.CommentID),
+			Token:     proposal.Token,
+			CommentID: c.CommentID,
+			ParentID:  c.ParentID,
+			UserID:    c.UserID,
+			Username:  c.Username,
+			Comment:   c.Comment,
+			Version:   c.Version,
+			CreatedAt: c.CreatedAt,
+			Timestamp: c.Timestamp,
+			Upvotes:   c.Upvotes,
+			Downvotes: c.Downvotes,
+			Deleted:   c.Deleted,
+			Reason:    c.Reason,
+		}
+		if err := p.db.Save(comment); err != nil {
+			return fmt.Errorf("error saving proposal comment: %s", err.Error())
+		}
+	}
+
+	log.Debugf("Politeia sync: synced %d comments of proposal %s", len(comments), proposal.Token)
+	return p.db.Save(&commentsSyncState{
+		Token:       proposal.Token,
+		NumComments: proposal.NumComments,
+		Timestamp:   proposal.Timestamp,
+		PublishedAt: proposal.PublishedAt,
+		SyncedAt:    time.Now().Unix(),
+	})
+}
@@ -0,0 +1,47 @@
+package politeia
+
+import "testing"
+
+func TestCommentsNeedSync(t *testing.T) {
+	state := &commentsSyncState{
+		Token:       "token",
+		NumComments: 3,
+		Timestamp:   1000,
+		PublishedAt: 900,
+	}
+	tests := []struct {
+		name     string
+		proposal *Proposal
+		want     bool
+	}{{
+		name:     "under discussion",
+		proposal: &Proposal{Category: ProposalCategoryPre, NumComments: 3, Timestamp: 1000, PublishedAt: 900},
+		want:     true,
+	}, {
+		name:     "voting",
+		proposal: &Proposal{Category: ProposalCategoryActive, NumComments: 3, Timestamp: 1000, PublishedAt: 900},
+		want:     true,
+	}, {
+		name:     "finished and unchanged",
+		proposal: &Proposal{Category: ProposalCategoryApproved, NumComments: 3, Timestamp: 1000, PublishedAt: 900},
+	}, {
+		name:     "new comments",
+		proposal: &Proposal{Category: ProposalCategoryRejected, NumComments: 4, Timestamp: 1000, PublishedAt: 900},
+		want:     true,
+	}, {
+		name:     "updated",
+		proposal: &Proposal{Category: ProposalCategoryAbandoned, NumComments: 3, Timestamp: 2000, PublishedAt: 900},
+		want:     true,
+	}, {
+		name:     "published again",
+		proposal: &Proposal{Category: ProposalCategoryApproved, NumComments: 3, Timestamp: 1000, PublishedAt: 1500},
+		want:     true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := commentsNeedSync(test.proposal, state); got != test.want {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
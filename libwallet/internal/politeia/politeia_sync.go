@@ -142,7 +142,7 @@ func (p *Politeia) checkForUpdates() error {
 		return err
 	}
 
-	return nil
+	return p.syncComments()
 }
 
 func (p *Politeia) handleNewProposals(proposals []Proposal) error {
@@ -232,6 +232,8 @@ func (p *Politeia) updateProposalDetails(oldProposal, updatedProposal Proposal)
 		default:
 			updatedProposal.Category = ProposalCategoryPre
 		}
+	} else if oldProposal.Version != updatedProposal.Version && updatedProposal.Category == ProposalCategoryPre {
+		callback = p.publishProposalEdited
 	}
 
 	err := p.db.Update(&updatedProposal)
@@ -239,6 +241,14 @@ func (p *Politeia) updateProposalDetails(oldProposal, updatedProposal Proposal)
 		return fmt.Errorf("error saving updated proposal: %s", err.Error())
 	}
 
+	if oldProposal.Version != updatedProposal.Version {
+		// Keep the new version so it can be compared with the previous
+		// ones without another request.
+		if _, err := p.fetchProposalVersion(updatedProposal.Token, updatedProposal.Version); err != nil {
+			log.Errorf("error fetching proposal version: %v", err)
+		}
+	}
+
 	if callback != nil {
 		callback(&updatedProposal)
 	}
@@ -389,6 +399,10 @@ func (p *Politeia) FetchProposalDescription(token string) (string, error) {
 		return "", err
 	}
 
+	if _, err := p.saveProposalVersion(&proposalDetailsReply.Proposal); err != nil {
+		log.Errorf("error saving proposal version: %s", err.Error())
+	}
+
 	for _, file := range proposalDetailsReply.Proposal.Files {
 		if file.Name == "index.md" {
 			b, err := base64.StdEncoding.DecodeString(file.Payload)
@@ -460,6 +474,7 @@ func (p *Politeia) ProposalVoteDetailsRaw(ctx context.Context, wallet *wallet.Wa
 	for _, v := range votesResults.Votes {
 		castVotes[v.Ticket] = v.VoteBit
 	}
+	p.updateVoteReceiptTimestamps(token, votesResults.Votes)
 
 	eligibletickets := make([]*EligibleTicket, 0)
 	votedTickets := make([]*ProposalVote, 0)
@@ -535,13 +550,19 @@ func (p *Politeia) CastVotes(ctx context.Context, wallet *wallet.Wallet, eligibl
 		return err
 	}
 
-	err = wallet.Unlock(ctx, []byte(passphrase), nil)
-	if err != nil {
-		return translateError(err)
+	// A wallet that's unlocked already, by the ticket buyer for example, is
+	// left unlocked if no passphrase is provided.
+	if passphrase != "" || wallet.Locked() {
+		err = wallet.Unlock(ctx, []byte(passphrase), nil)
+		if err != nil {
+			return translateError(err)
+		}
+		defer wallet.Lock()
 	}
-	defer wallet.Lock()
 
 	votes := make([]tkv1.CastVote, 0)
+	addresses := make(map[string]string, len(eligibleTickets))
+	options := make(map[string]string, len(eligibleTickets))
 	for _, eligibleTicket := range eligibleTickets {
 		var voteBitHex string
 		// Verify vote bit
@@ -574,9 +595,50 @@ func (p *Politeia) CastVotes(ctx context.Context, wallet *wallet.Wallet, eligibl
 		}
 
 		votes = append(votes, singleVote)
+		addresses[ticket.Hash] = ticket.Address
+		options[ticket.Hash] = eligibleTicket.Bit
 	}
 
-	return p.client.sendVotes(votes)
+	replies, err := p.client.sendVotes(votes)
+	if err != nil {
+		return err
+	}
+
+	// Keep a receipt of every accepted vote before reporting any rejected
+	// one.
+	var voteErr error
+	now := time.Now().Unix()
+	for i, reply := range replies {
+		if reply.ErrorContext != "" {
+			if voteErr == nil {
+				voteErr = fmt.Errorf(reply.ErrorContext)
+			}
+			continue
+		}
+		if i >= len(votes) || votes[i].Ticket != reply.Ticket {
+			continue
+		}
+
+		receipt := &VoteReceipt{
+			ID:              token + ":" + reply.Ticket,
+			Token:           token,
+			Ticket:          reply.Ticket,
+			VoteBit:         votes[i].VoteBit,
+			VoteOption:      options[reply.Ticket],
+			Address:         addresses[reply.Ticket],
+			Signature:       votes[i].Signature,
+			Receipt:         reply.Receipt,
+			ServerPublicKey: p.client.version.PubKey,
+			Timestamp:       now,
+		}
+		if err := receipt.verify(p.client.version.PubKey); err != nil {
+			log.Errorf("Invalid receipt for the vote of ticket %s: %v", reply.Ticket, err)
+		}
+		if err := p.db.Save(receipt); err != nil {
+			log.Errorf("Error saving vote receipt: %v", err)
+		}
+	}
+	return voteErr
 }
 
 func (p *Politeia) AddSyncCallback(syncCallback proposalSyncCallback, uniqueIdentifier string) error {
@@ -634,6 +696,15 @@ func (p *Politeia) publishVoteFinished(proposal *Proposal) {
 	}
 }
 
+func (p *Politeia) publishProposalEdited(proposal *Proposal) {
+	p.syncCallbacksMtx.Lock()
+	defer p.syncCallbacksMtx.Unlock()
+
+	for _, syncCallback := range p.syncCallbacks {
+		syncCallback(proposal.Name, utils.ProposalStatusEdited)
+	}
+}
+
 func getVotesCount(options []www.VoteOptionResult) (int32, int32) {
 	var yes, no int32
 
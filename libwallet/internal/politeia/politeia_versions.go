@@ -0,0 +1,152 @@
+package politeia
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+	www "github.com/decred/politeia/politeiawww/api/www/v1"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// FetchProposalVersions returns the published versions of the proposal with
+// the provided token, oldest first. Versions that weren't fetched before are
+// fetched from the server and saved, published versions never change.
+func (p *Politeia) FetchProposalVersions(token string) ([]*ProposalVersion, error) {
+	proposal, err := p.GetProposalRaw(token)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := strconv.Atoi(proposal.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal version %q", proposal.Version)
+	}
+
+	var saved []*ProposalVersion
+	err = p.db.Select(q.Eq("Token", token)).Find(&saved)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, fmt.Errorf("error fetching proposal versions: %s", err.Error())
+	}
+
+	versions := make(map[string]*ProposalVersion, latest)
+	for _, version := range saved {
+		versions[version.Version] = version
+	}
+
+	if len(versions) < latest {
+		p.mu.RLock()
+		err = p.getClient()
+		if err == nil {
+			for v := 1; v <= latest; v++ {
+				version := strconv.Itoa(v)
+				if _, ok := versions[version]; ok {
+					continue
+				}
+				versions[version], err = p.fetchProposalVersion(token, version)
+				if err != nil {
+					break
+				}
+			}
+		}
+		p.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := make([]*ProposalVersion, 0, len(versions))
+	for _, version := range versions {
+		sorted = append(sorted, version)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		vi, _ := strconv.Atoi(sorted[i].Version)
+		vj, _ := strconv.Atoi(sorted[j].Version)
+		return vi < vj
+	})
+	return sorted, nil
+}
+
+// ProposalVersionDiff returns the line diff of the index files of two versions
+// of the proposal with the provided token.
+func (p *Politeia) ProposalVersionDiff(token, fromVersion, toVersion string) ([]ProposalDiffLine, error) {
+	versions, err := p.FetchProposalVersions(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to *ProposalVersion
+	for _, version := range versions {
+		if version.Version == fromVersion {
+			from = version
+		}
+		if version.Version == toVersion {
+			to = version
+		}
+	}
+	if from == nil || to == nil {
+		return nil, fmt.Errorf("proposal version not found")
+	}
+
+	a := strings.Split(from.IndexFile, "\n")
+	b := strings.Split(to.IndexFile, "\n")
+	diff := make([]ProposalDiffLine, 0, len(b))
+	appendLines := func(op ProposalDiffOp, lines []string) {
+		for _, line := range lines {
+			diff = append(diff, ProposalDiffLine{Op: op, Text: line})
+		}
+	}
+	for _, opCode := range difflib.NewMatcher(a, b).GetOpCodes() {
+		switch opCode.Tag {
+		case 'e':
+			appendLines(ProposalDiffUnchanged, a[opCode.I1:opCode.I2])
+		case 'd':
+			appendLines(ProposalDiffRemoved, a[opCode.I1:opCode.I2])
+		case 'i':
+			appendLines(ProposalDiffAdded, b[opCode.J1:opCode.J2])
+		case 'r':
+			appendLines(ProposalDiffRemoved, a[opCode.I1:opCode.I2])
+			appendLines(ProposalDiffAdded, b[opCode.J1:opCode.J2])
+		}
+	}
+	return diff, nil
+}
+
+// fetchProposalVersion fetches and saves a version of a proposal. It must be
+// called with p.mu held.
+func (p *Politeia) fetchProposalVersion(token, version string) (*ProposalVersion, error) {
+	reply, err := p.client.proposalVersionDetails(token, version)
+	if err != nil {
+		return nil, err
+	}
+	return p.saveProposalVersion(&reply.Proposal)
+}
+
+func (p *Politeia) saveProposalVersion(record *www.ProposalRecord) (*ProposalVersion, error) {
+	token := record.CensorshipRecord.Token
+	version := &ProposalVersion{
+		ID:        token + ":" + record.Version,
+		Token:     token,
+		Version:   record.Version,
+		Name:      record.Name,
+		Timestamp: record.Timestamp,
+	}
+	for _, file := range record.Files {
+		if file.Name == "index.md" {
+			b, err := base64.StdEncoding.DecodeString(file.Payload)
+			if err != nil {
+				return nil, err
+			}
+			version.IndexFile = string(b)
+			break
+		}
+	}
+
+	if err := p.db.Save(version); err != nil {
+		return nil, fmt.Errorf("error saving proposal version: %s", err.Error())
+	}
+	return version, nil
+}
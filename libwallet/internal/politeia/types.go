@@ -33,6 +33,89 @@ type Proposal struct {
 	QuorumPercentage int32  `json:"quorumpercentage"`
 	PassPercentage   int32  `json:"passpercentage"`
 	Type             ProposalType
+	// Amount is the funding requested by the proposal in USD cents, paid
+	// between StartDate and EndDate.
+	Amount    uint64 `json:"amount"`
+	StartDate int64  `json:"startdate"`
+	EndDate   int64  `json:"enddate"`
+	Domain    string `json:"domain"`
+}
+
+// ProposalComment is a comment on a proposal. Replies refer to the comment
+// they answer with ParentID, top level comments have a zero ParentID.
+type ProposalComment struct {
+	ID        string `storm:"id"` // token:commentid
+	Token     string `json:"token" storm:"index"`
+	CommentID uint32 `json:"commentid"`
+	ParentID  uint32 `json:"parentid"`
+	UserID    string `json:"userid"`
+	Username  string `json:"username"`
+	Comment   string `json:"comment"`
+	Version   uint32 `json:"version"`
+	CreatedAt int64  `json:"createdat"`
+	Timestamp int64  `json:"timestamp"`
+	Upvotes   uint64 `json:"upvotes"`
+	Downvotes uint64 `json:"downvotes"`
+	Deleted   bool   `json:"deleted"`
+	Reason    string `json:"reason"`
+	// Depth is the number of comments above this one in its thread. It's set
+	// by GetProposalComments.
+	Depth int `json:"depth"`
+}
+
+// commentsSyncState records the state of a proposal when its comments were
+// last synced.
+type commentsSyncState struct {
+	Token       string `storm:"id"`
+	NumComments int32
+	Timestamp   int64
+	PublishedAt int64
+	SyncedAt    int64
+}
+
+// ProposalVersion is a published version of a proposal.
+type ProposalVersion struct {
+	ID        string `storm:"id"` // token:version
+	Token     string `json:"token" storm:"index"`
+	Version   string `json:"version"`
+	Name      string `json:"name"`
+	Timestamp int64  `json:"timestamp"`
+	IndexFile string `json:"indexfile"`
+}
+
+// ProposalDiffOp tells if a line of a ProposalDiffLine was kept, added or
+// removed.
+type ProposalDiffOp int
+
+const (
+	ProposalDiffUnchanged ProposalDiffOp = iota
+	ProposalDiffAdded
+	ProposalDiffRemoved
+)
+
+// ProposalDiffLine is a line of the diff between two versions of a
+// proposal's index file.
+type ProposalDiffLine struct {
+	Op   ProposalDiffOp
+	Text string
+}
+
+// VoteReceipt proves how a ticket voted on a proposal: it holds the vote
+// signed with the ticket's address and the politeia server's signature of
+// that signature, so it can be verified without the server.
+type VoteReceipt struct {
+	ID              string `storm:"id" json:"-"` // token:ticket
+	Token           string `json:"token" storm:"index"`
+	Ticket          string `json:"ticket"`
+	VoteBit         string `json:"votebit"` // hex encoded
+	VoteOption      string `json:"voteoption"`
+	Address         string `json:"address"`
+	Signature       string `json:"signature"`
+	Receipt         string `json:"receipt"`
+	ServerPublicKey string `json:"serverpublickey"`
+	// Timestamp is when the server recorded the vote. It's the time the
+	// vote was sent until the server's time is synced.
+	Timestamp int64 `json:"timestamp"`
 }
 
 type ProposalOverview struct {
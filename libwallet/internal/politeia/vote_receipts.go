@@ -0,0 +1,171 @@
+package politeia
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+	tkv1 "github.com/decred/politeia/politeiawww/api/ticketvote/v1"
+	"github.com/decred/politeia/politeiawww/client"
+)
+
+// VoteReceipts returns the receipts of the votes cast from this app on the
+// proposal with the provided token, or on all proposals if token is empty.
+func (p *Politeia) VoteReceipts(token string) ([]*VoteReceipt, error) {
+	matcher := q.True()
+	if token != "" {
+		matcher = q.Eq("Token", token)
+	}
+
+	var receipts []*VoteReceipt
+	err := p.db.Select(matcher).OrderBy("Timestamp").Find(&receipts)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, fmt.Errorf("error fetching vote receipts: %s", err.Error())
+	}
+	return receipts, nil
+}
+
+// ExportVoteReceipts returns the receipts of VoteReceipts as JSON, to be
+// checked with VerifyExportedVoteReceipts.
+func (p *Politeia) ExportVoteReceipts(token string) (string, error) {
+	receipts, err := p.VoteReceipts(token)
+	if err != nil {
+		return "", err
+	}
+	if receipts == nil {
+		receipts = []*VoteReceipt{}
+	}
+
+	b, err := json.MarshalIndent(receipts, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error marshalling vote receipts: %s", err.Error())
+	}
+	return string(b), nil
+}
+
+// VerifyVoteReceipt checks that the receipt's vote was signed by its ticket's
+// address and that the receipt was signed by the politeia server key pinned
+// by this app, see serverPublicKey. The key saved in the receipt isn't trusted
+// since anyone editing the receipt could replace it.
+func (p *Politeia) VerifyVoteReceipt(receipt *VoteReceipt) error {
+	serverPubKey, err := p.serverPublicKey()
+	if err != nil {
+		return err
+	}
+	return receipt.verify(serverPubKey)
+}
+
+// VerifyExportedVoteReceipts parses receipts exported by ExportVoteReceipts
+// and verifies each of them against the pinned politeia server key. It
+// returns the receipts with the verification error of each, nil for valid
+// receipts.
+func (p *Politeia) VerifyExportedVoteReceipts(data string) ([]*VoteReceipt, []error, error) {
+	var receipts []*VoteReceipt
+	if err := json.Unmarshal([]byte(data), &receipts); err != nil {
+		return nil, nil, fmt.Errorf("invalid vote receipts: %s", err.Error())
+	}
+
+	serverPubKey, err := p.serverPublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errs := make([]error, len(receipts))
+	for i, receipt := range receipts {
+		errs[i] = receipt.verify(serverPubKey)
+	}
+	return receipts, errs, nil
+}
+
+// serverPublicKey returns the public key the politeia server signs its
+// replies with. The first key the app received from the server is kept in the
+// db so receipts can be verified while the server is unreachable; the server
+// is only asked for its key if none was saved yet.
+func (p *Politeia) serverPublicKey() (string, error) {
+	if pubKey := p.pinnedServerPublicKey(); pubKey != "" {
+		return pubKey, nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if err := p.getClient(); err != nil {
+		return "", err
+	}
+	return p.client.version.PubKey, nil
+}
+
+func (p *Politeia) pinnedServerPublicKey() (pubKey string) {
+	err := p.db.Get(configDBBkt, serverPublicKeyConfigKey, &pubKey)
+	if err != nil && err != storm.ErrNotFound {
+		log.Errorf("error reading config value for key: %s, error: %v", serverPublicKeyConfigKey, err)
+	}
+	return pubKey
+}
+
+// pinServerPublicKey saves pubKey as the politeia server key if no key was
+// saved yet. A different key is only logged, receipts are still verified
+// against the pinned one.
+func (p *Politeia) pinServerPublicKey(pubKey string) {
+	if pubKey == "" {
+		return
+	}
+
+	pinned := p.pinnedServerPublicKey()
+	if pinned == pubKey {
+		return
+	}
+	if pinned != "" {
+		log.Warnf("Politeia server key changed from %s to %s, vote receipts are still verified against the first one", pinned, pubKey)
+		return
+	}
+
+	if err := p.db.Set(configDBBkt, serverPublicKeyConfigKey, &pubKey); err != nil {
+		log.Errorf("error setting config value for key: %s, error: %v", serverPublicKeyConfigKey, err)
+	}
+}
+
+// verify checks the receipt against the provided politeia server key.
+func (receipt *VoteReceipt) verify(serverPubKey string) error {
+	if receipt.ServerPublicKey != "" && receipt.ServerPublicKey != serverPubKey {
+		return fmt.Errorf("receipt was not issued by the politeia server key %s", serverPubKey)
+	}
+	// CastVoteDetailsVerify reads the network from the address prefix.
+	if len(receipt.Address) < 2 {
+		return fmt.Errorf("invalid vote address %q", receipt.Address)
+	}
+	return client.CastVoteDetailsVerify(tkv1.CastVoteDetails{
+		Token:     receipt.Token,
+		Ticket:    receipt.Ticket,
+		VoteBit:   receipt.VoteBit,
+		Address:   receipt.Address,
+		Signature: receipt.Signature,
+		Receipt:   receipt.Receipt,
+		Timestamp: receipt.Timestamp,
+	}, serverPubKey)
+}
+
+// updateVoteReceiptTimestamps sets the time the server recorded the votes of
+// the saved receipts of a proposal, taken from its vote results.
+func (p *Politeia) updateVoteReceiptTimestamps(token string, votes []tkv1.CastVoteDetails) {
+	receipts, err := p.VoteReceipts(token)
+	if err != nil || len(receipts) == 0 {
+		return
+	}
+
+	results := make(map[string]tkv1.CastVoteDetails, len(votes))
+	for _, vote := range votes {
+		results[vote.Ticket] = vote
+	}
+	for _, receipt := range receipts {
+		result, ok := results[receipt.Ticket]
+		if !ok || result.Receipt != receipt.Receipt || result.Timestamp == receipt.Timestamp {
+			continue
+		}
+		receipt.Timestamp = result.Timestamp
+		if err := p.db.Save(receipt); err != nil {
+			log.Errorf("Error saving vote receipt: %v", err)
+		}
+	}
+}
@@ -47,6 +47,12 @@ const (
 	ProposalTypeRFPSubmission = politeia.ProposalTypeRFPSubmission
 )
 
+const (
+	ProposalDiffUnchanged = politeia.ProposalDiffUnchanged
+	ProposalDiffAdded     = politeia.ProposalDiffAdded
+	ProposalDiffRemoved   = politeia.ProposalDiffRemoved
+)
+
 type Proposal struct {
 	politeia.Proposal
 }
@@ -67,6 +73,25 @@ type EligibleTicket struct {
 	politeia.EligibleTicket
 }
 
+type VoteReceipt struct {
+	politeia.VoteReceipt
+}
+
+// VerifyExportedVoteReceipts parses vote receipts exported with
+// Politeia.ExportVoteReceipts and returns them with the verification error of
+// each, nil for valid receipts.
+func (mgr *AssetsManager) VerifyExportedVoteReceipts(data string) ([]*VoteReceipt, []error, error) {
+	receipts, errs, err := mgr.Politeia.VerifyExportedVoteReceipts(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	wrapped := make([]*VoteReceipt, len(receipts))
+	for i, receipt := range receipts {
+		wrapped[i] = &VoteReceipt{VoteReceipt: *receipt}
+	}
+	return wrapped, errs, nil
+}
+
 type ProposalVote struct {
 	politeia.ProposalVote
 }
@@ -0,0 +1,144 @@
+package libwallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v4/errors"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/internal/politeia"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// WalletVoteDetails is the vote details of a proposal for one DCR wallet.
+type WalletVoteDetails struct {
+	WalletID   int
+	WalletName string
+	ProposalVoteDetails
+	// Err is set if the vote details of the wallet couldn't be loaded, in
+	// which case the wallet has no eligible tickets.
+	Err error
+}
+
+// WalletVotes is the votes a DCR wallet casts on a proposal.
+type WalletVotes struct {
+	WalletID   int
+	WalletName string
+	Votes      []*ProposalVote
+	YesVotes   int
+	NoVotes    int
+}
+
+// WalletVoteResult is the result of casting the votes of a DCR wallet.
+type WalletVoteResult struct {
+	WalletVotes
+	Err error
+}
+
+// AllWalletsProposalVoteDetails returns the vote details of the proposal with
+// the provided token for every opened DCR wallet that can sign votes. A wallet
+// whose vote details can't be loaded doesn't prevent the others from voting,
+// its error is set in its WalletVoteDetails instead. An error is only returned
+// if ctx is canceled.
+func (mgr *AssetsManager) AllWalletsProposalVoteDetails(ctx context.Context, token string) ([]*WalletVoteDetails, error) {
+	allDetails := make([]*WalletVoteDetails, 0)
+	for _, wallet := range mgr.AllDCRWallets() {
+		if !wallet.WalletOpened() || wallet.IsWatchingOnlyWallet() {
+			continue
+		}
+
+		walletDetails := &WalletVoteDetails{
+			WalletID:   wallet.GetWalletID(),
+			WalletName: wallet.GetWalletName(),
+		}
+		details, err := mgr.Politeia.ProposalVoteDetailsRaw(ctx, wallet.Internal().DCR, token)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			log.Errorf("[%d] Error loading the vote details of proposal %s: %v", walletDetails.WalletID, token, err)
+			walletDetails.Err = err
+		} else {
+			walletDetails.ProposalVoteDetails = ProposalVoteDetails{ProposalVoteDetails: *details}
+		}
+		allDetails = append(allDetails, walletDetails)
+	}
+	return allDetails, nil
+}
+
+// CombineVoteDetails returns the vote details of all the wallets as if their
+// tickets were in a single wallet.
+func CombineVoteDetails(allDetails []*WalletVoteDetails) *ProposalVoteDetails {
+	combined := &ProposalVoteDetails{}
+	for _, details := range allDetails {
+		combined.EligibleTickets = append(combined.EligibleTickets, details.EligibleTickets...)
+		combined.Votes = append(combined.Votes, details.Votes...)
+		combined.YesVotes += details.YesVotes
+		combined.NoVotes += details.NoVotes
+	}
+	return combined
+}
+
+// SplitProposalVotes assigns the yes votes and then the no votes to the
+// eligible tickets of the wallets, in the order of the wallets. Wallets left
+// without votes are omitted.
+func SplitProposalVotes(allDetails []*WalletVoteDetails, yesVotes, noVotes int) ([]*WalletVotes, error) {
+	if yesVotes < 0 || noVotes < 0 || yesVotes+noVotes > len(CombineVoteDetails(allDetails).EligibleTickets) {
+		return nil, errors.E(errors.Invalid, "not enough eligible tickets for the votes")
+	}
+
+	split := make([]*WalletVotes, 0, len(allDetails))
+	for _, details := range allDetails {
+		walletVotes := &WalletVotes{
+			WalletID:   details.WalletID,
+			WalletName: details.WalletName,
+		}
+		for _, ticket := range details.EligibleTickets {
+			bit := VoteBitYes
+			switch {
+			case yesVotes > 0:
+				yesVotes--
+				walletVotes.YesVotes++
+			case noVotes > 0:
+				bit = VoteBitNo
+				noVotes--
+				walletVotes.NoVotes++
+			default:
+				continue
+			}
+			walletVotes.Votes = append(walletVotes.Votes, WrapVote(ticket.Hash, ticket.Address, bit))
+		}
+		if len(walletVotes.Votes) > 0 {
+			split = append(split, walletVotes)
+		}
+	}
+	return split, nil
+}
+
+// CastProposalVotes casts the votes of each wallet on the proposal with the
+// provided token and returns the result for each wallet. The passphrases are
+// keyed by wallet ID; wallets that are unlocked already, like those running
+// the ticket buyer, don't need one and are left unlocked.
+func (mgr *AssetsManager) CastProposalVotes(ctx context.Context, token string, split []*WalletVotes, passphrases map[int]string) []*WalletVoteResult {
+	results := make([]*WalletVoteResult, len(split))
+	for i, walletVotes := range split {
+		results[i] = &WalletVoteResult{WalletVotes: *walletVotes}
+
+		asset, ok := mgr.Assets.DCR.Wallets[walletVotes.WalletID].(*dcr.Asset)
+		if !ok {
+			results[i].Err = errors.New(utils.ErrNotExist)
+			continue
+		}
+
+		votes := make([]*politeia.ProposalVote, len(walletVotes.Votes))
+		for j, vote := range walletVotes.Votes {
+			votes[j] = &vote.ProposalVote
+		}
+		err := mgr.Politeia.CastVotes(ctx, asset.Internal().DCR, votes, token, passphrases[walletVotes.WalletID])
+		if err != nil {
+			log.Errorf("[%d] Error casting proposal votes: %v", walletVotes.WalletID, err)
+			results[i].Err = err
+		}
+	}
+	return results
+}
@@ -0,0 +1,111 @@
+package libwallet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/internal/politeia"
+)
+
+func testWalletVoteDetails(walletID, tickets int) *WalletVoteDetails {
+	details := &WalletVoteDetails{WalletID: walletID}
+	for i := 0; i < tickets; i++ {
+		details.EligibleTickets = append(details.EligibleTickets, &politeia.EligibleTicket{
+			Hash:    string(rune('a'+walletID)) + string(rune('0'+i)),
+			Address: "address",
+		})
+	}
+	return details
+}
+
+func TestSplitProposalVotes(t *testing.T) {
+	type walletSplit struct {
+		walletID int
+		yes, no  int
+	}
+	tests := []struct {
+		name     string
+		details  []*WalletVoteDetails
+		yesVotes int
+		noVotes  int
+		want     []walletSplit
+		wantErr  bool
+	}{
+		{
+			name:     "yes votes fill the first wallets, then no votes",
+			details:  []*WalletVoteDetails{testWalletVoteDetails(1, 2), testWalletVoteDetails(2, 3)},
+			yesVotes: 3,
+			noVotes:  2,
+			want:     []walletSplit{{walletID: 1, yes: 2}, {walletID: 2, yes: 1, no: 2}},
+		},
+		{
+			name:     "wallets left without votes are omitted",
+			details:  []*WalletVoteDetails{testWalletVoteDetails(1, 2), testWalletVoteDetails(2, 3)},
+			yesVotes: 0,
+			noVotes:  1,
+			want:     []walletSplit{{walletID: 1, no: 1}},
+		},
+		{
+			name: "wallets whose details failed to load are skipped",
+			details: []*WalletVoteDetails{
+				{WalletID: 1, Err: errors.New("unreachable")},
+				testWalletVoteDetails(2, 2),
+			},
+			yesVotes: 1,
+			noVotes:  1,
+			want:     []walletSplit{{walletID: 2, yes: 1, no: 1}},
+		},
+		{
+			name:     "more votes than eligible tickets",
+			details:  []*WalletVoteDetails{testWalletVoteDetails(1, 2), testWalletVoteDetails(2, 1)},
+			yesVotes: 2,
+			noVotes:  2,
+			wantErr:  true,
+		},
+		{
+			name:     "negative votes",
+			details:  []*WalletVoteDetails{testWalletVoteDetails(1, 2)},
+			yesVotes: -1,
+			noVotes:  1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			split, err := SplitProposalVotes(tc.details, tc.yesVotes, tc.noVotes)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(split) != len(tc.want) {
+				t.Fatalf("expected votes for %d wallets, got %d", len(tc.want), len(split))
+			}
+			for i, want := range tc.want {
+				got := split[i]
+				if got.WalletID != want.walletID || got.YesVotes != want.yes || got.NoVotes != want.no {
+					t.Fatalf("expected wallet %d to vote %d yes and %d no, got wallet %d with %d yes and %d no",
+						want.walletID, want.yes, want.no, got.WalletID, got.YesVotes, got.NoVotes)
+				}
+				if len(got.Votes) != want.yes+want.no {
+					t.Fatalf("expected %d votes for wallet %d, got %d", want.yes+want.no, got.WalletID, len(got.Votes))
+				}
+				for j, vote := range got.Votes {
+					wantBit := VoteBitYes
+					if j >= want.yes {
+						wantBit = VoteBitNo
+					}
+					if vote.Bit != wantBit {
+						t.Fatalf("expected vote %d of wallet %d to be %s, got %s", j, got.WalletID, wantBit, vote.Bit)
+					}
+				}
+			}
+		})
+	}
+}
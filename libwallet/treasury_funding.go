@@ -0,0 +1,173 @@
+package libwallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/internal/politeia"
+)
+
+// treasuryFundingMonths is the number of months of treasury activity in a
+// TreasuryFunding.
+const treasuryFundingMonths = 12
+
+// TreasuryFunding relates the budgets of the approved proposals to the
+// treasury's balance and spending.
+type TreasuryFunding struct {
+	// Balance is the treasury balance in atoms.
+	Balance int64
+	// Months is the treasury activity of the last months, oldest first.
+	Months []*TreasuryMonth
+	// Proposals are the approved proposals that requested funding, the ones
+	// whose funding ends last first.
+	Proposals []*FundedProposal
+}
+
+// TreasuryMonth is the treasury activity of a month.
+type TreasuryMonth struct {
+	Time time.Time
+	// Received and Spent are in DCR.
+	Received float64
+	Spent    float64
+	// Budgeted is the part of the approved proposals' budgets, in USD, that
+	// falls in the month, assuming budgets are spent evenly over the funding
+	// period.
+	Budgeted float64
+}
+
+// FundedProposal is the budget of an approved proposal.
+type FundedProposal struct {
+	Token  string
+	Name   string
+	Domain string
+	// Budget is in USD.
+	Budget    float64
+	StartDate int64
+	EndDate   int64
+}
+
+// Remaining returns the part of the budget, in USD, for the rest of the
+// funding period after t, assuming it is spent evenly over the period.
+func (p *FundedProposal) Remaining(t time.Time) float64 {
+	return p.Budget * periodOverlap(p.StartDate, p.EndDate, t.Unix(), p.EndDate)
+}
+
+// SpendRate returns the average DCR spent per month in the last months
+// complete months, leaving out the current month.
+func (f *TreasuryFunding) SpendRate(months int) float64 {
+	complete := f.Months
+	if len(complete) > 0 {
+		complete = complete[:len(complete)-1]
+	}
+	if months > len(complete) {
+		months = len(complete)
+	}
+	if months == 0 {
+		return 0
+	}
+
+	var spent float64
+	for _, month := range complete[len(complete)-months:] {
+		spent += month.Spent
+	}
+	return spent / float64(months)
+}
+
+// RemainingBudget returns the budgets of the approved proposals, in USD, that
+// are still to be paid after t.
+func (f *TreasuryFunding) RemainingBudget(t time.Time) float64 {
+	var remaining float64
+	for _, proposal := range f.Proposals {
+		remaining += proposal.Remaining(t)
+	}
+	return remaining
+}
+
+// TreasuryFunding returns the treasury balance and monthly activity from
+// dcrdata with the budgets of the approved proposals that were synced from
+// politeia.
+func (mgr *AssetsManager) TreasuryFunding() (*TreasuryFunding, error) {
+	details, err := mgr.ExternalService.GetTreasuryDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	treasuryIO, err := mgr.ExternalService.GetTreasuryIO("month")
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := mgr.Politeia.GetProposalsRaw(ProposalCategoryApproved, 0, 0, true, "")
+	if err != nil {
+		return nil, err
+	}
+
+	funding := &TreasuryFunding{
+		Balance:   details.Balance,
+		Proposals: fundedProposals(approved),
+	}
+
+	first := len(treasuryIO.Time) - treasuryFundingMonths
+	if first < 0 {
+		first = 0
+	}
+	for i := first; i < len(treasuryIO.Time); i++ {
+		month := &TreasuryMonth{Time: treasuryIO.Time[i]}
+		if i < len(treasuryIO.Received) {
+			month.Received = treasuryIO.Received[i]
+		}
+		if i < len(treasuryIO.Sent) {
+			month.Spent = treasuryIO.Sent[i]
+		}
+
+		monthTime := month.Time.UTC()
+		start := time.Date(monthTime.Year(), monthTime.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 1, 0)
+		for _, proposal := range funding.Proposals {
+			month.Budgeted += proposal.Budget * periodOverlap(proposal.StartDate, proposal.EndDate, start.Unix(), end.Unix())
+		}
+		funding.Months = append(funding.Months, month)
+	}
+
+	return funding, nil
+}
+
+func fundedProposals(approved []politeia.Proposal) []*FundedProposal {
+	proposals := make([]*FundedProposal, 0, len(approved))
+	for _, proposal := range approved {
+		if proposal.Amount == 0 || proposal.EndDate <= proposal.StartDate {
+			continue
+		}
+		proposals = append(proposals, &FundedProposal{
+			Token:     proposal.Token,
+			Name:      proposal.Name,
+			Domain:    proposal.Domain,
+			Budget:    float64(proposal.Amount) / 100,
+			StartDate: proposal.StartDate,
+			EndDate:   proposal.EndDate,
+		})
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].EndDate > proposals[j].EndDate
+	})
+	return proposals
+}
+
+// periodOverlap returns the fraction of the period from start to end that
+// falls between from and to.
+func periodOverlap(start, end, from, to int64) float64 {
+	if end <= start {
+		return 0
+	}
+	if from < start {
+		from = start
+	}
+	if to > end {
+		to = end
+	}
+	if to <= from {
+		return 0
+	}
+	return float64(to-from) / float64(end-start)
+}
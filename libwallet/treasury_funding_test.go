@@ -0,0 +1,174 @@
+package libwallet
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func unixDate(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+func TestPeriodOverlap(t *testing.T) {
+	// January to March 2024, 91 days.
+	start, end := unixDate(2024, time.January, 1), unixDate(2024, time.April, 1)
+	tests := []struct {
+		name       string
+		start, end int64
+		from, to   int64
+		want       float64
+	}{
+		{
+			name:  "window covers the period",
+			start: start, end: end,
+			from: unixDate(2023, time.December, 1), to: unixDate(2024, time.May, 1),
+			want: 1,
+		},
+		{
+			name:  "month inside the period",
+			start: start, end: end,
+			from: unixDate(2024, time.February, 1), to: unixDate(2024, time.March, 1),
+			want: 29.0 / 91,
+		},
+		{
+			name:  "period starts within the window",
+			start: unixDate(2024, time.January, 11), end: end,
+			from: start, to: unixDate(2024, time.February, 1),
+			want: 21.0 / 81,
+		},
+		{
+			name:  "period ends within the window",
+			start: start, end: unixDate(2024, time.March, 16),
+			from: unixDate(2024, time.March, 1), to: end,
+			want: 15.0 / 75,
+		},
+		{
+			name:  "period ends before the window",
+			start: start, end: end,
+			from: unixDate(2024, time.May, 1), to: unixDate(2024, time.June, 1),
+			want: 0,
+		},
+		{
+			name:  "period ends when the window starts",
+			start: start, end: end,
+			from: end, to: unixDate(2024, time.May, 1),
+			want: 0,
+		},
+		{
+			name:  "period starts after the window",
+			start: start, end: end,
+			from: unixDate(2023, time.November, 1), to: unixDate(2023, time.December, 1),
+			want: 0,
+		},
+		{
+			name:  "empty period",
+			start: end, end: start,
+			from: start, to: end,
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := periodOverlap(tc.start, tc.end, tc.from, tc.to)
+			if math.Abs(got-tc.want) > 1e-9 {
+				t.Fatalf("expected overlap %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFundedProposalRemaining(t *testing.T) {
+	proposal := &FundedProposal{
+		Budget:    9100,
+		StartDate: unixDate(2024, time.January, 1),
+		EndDate:   unixDate(2024, time.April, 1),
+	}
+	tests := []struct {
+		name string
+		at   time.Time
+		want float64
+	}{
+		{
+			name: "before the funding period",
+			at:   time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+			want: 9100,
+		},
+		{
+			name: "during the funding period",
+			at:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want: 3100,
+		},
+		{
+			name: "after the funding period",
+			at:   time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := proposal.Remaining(tc.at)
+			if math.Abs(got-tc.want) > 1e-6 {
+				t.Fatalf("expected remaining budget %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTreasurySpendRate(t *testing.T) {
+	testMonths := func(spent ...float64) []*TreasuryMonth {
+		months := make([]*TreasuryMonth, len(spent))
+		for i, s := range spent {
+			months[i] = &TreasuryMonth{Spent: s}
+		}
+		return months
+	}
+
+	tests := []struct {
+		name   string
+		months []*TreasuryMonth
+		count  int
+		want   float64
+	}{
+		{
+			name:   "current month is left out",
+			months: testMonths(1, 2, 3, 4, 100),
+			count:  2,
+			want:   3.5,
+		},
+		{
+			name:   "more months than available",
+			months: testMonths(1, 2, 3, 4, 100),
+			count:  12,
+			want:   2.5,
+		},
+		{
+			name:   "only the current month",
+			months: testMonths(100),
+			count:  12,
+			want:   0,
+		},
+		{
+			name:  "no months",
+			count: 12,
+			want:  0,
+		},
+		{
+			name:   "zero months requested",
+			months: testMonths(1, 2, 100),
+			count:  0,
+			want:   0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			funding := &TreasuryFunding{Months: tc.months}
+			if got := funding.SpendRate(tc.count); got != tc.want {
+				t.Fatalf("expected spend rate %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// Package txexport writes the transaction history of wallets to CSV and JSON
+// files, e.g. for accounting purposes.
+package txexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// Format is the file format of an export.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	JSON Format = "json"
+
+	// AllAccounts is used in place of an account number to export the
+	// transactions of all the accounts of a wallet.
+	AllAccounts int32 = -1
+)
+
+var csvHeaders = []string{
+	"time", "wallet", "asset", "hash", "type", "direction", "amount", "fee",
+	"label", "confirmations", "ticket_spender", "fiat_value", "fiat_currency",
+}
+
+// Record is the exported information of a transaction. Amounts are in coins.
+// FiatValue is the value of Amount at the time of the transaction and is nil
+// if the rate source couldn't provide a rate for that time.
+type Record struct {
+	Time          time.Time       `json:"time"`
+	Wallet        string          `json:"wallet"`
+	Asset         utils.AssetType `json:"asset"`
+	Hash          string          `json:"hash"`
+	Type          string          `json:"type"`
+	Direction     string          `json:"direction"`
+	Amount        float64         `json:"amount"`
+	Fee           float64         `json:"fee"`
+	Label         string          `json:"label,omitempty"`
+	Confirmations int32           `json:"confirmations"`
+	TicketSpender string          `json:"ticket_spender,omitempty"`
+	FiatValue     *float64        `json:"fiat_value,omitempty"`
+	FiatCurrency  string          `json:"fiat_currency,omitempty"`
+}
+
+// Records returns the transactions of the account of wallet, or of all its
+// accounts if account is AllAccounts, newest first. A transaction belongs to
+// an account if any of its inputs or outputs does. rateSource is used to look
+// up fiat values and may be nil. Rates are looked up oldest first since the
+// rate source fetches them in batches of the hours following the requested
+// one. If a rate can't be fetched, the error is logged, no further rates are
+// looked up and the remaining transactions are exported without a fiat value.
+// MissingFiatValues counts them.
+func Records(wallet sharedW.Asset, account int32, rateSource ext.RateSource) ([]*Record, error) {
+	txs, err := wallet.GetTransactionsRaw(0, math.MaxInt32, utils.TxFilterAll, true, "")
+	if err != nil {
+		return nil, fmt.Errorf("wallet.GetTransactionsRaw error: %w", err)
+	}
+
+	market, hasMarket := values.AssetExchangeMarketValue[wallet.GetAssetType()]
+	var fiatCurrency string
+	if hasMarket {
+		_, fiatCurrency, _ = strings.Cut(market.String(), ext.MktSep)
+	}
+
+	bestBlock := wallet.GetBestBlockHeight()
+	records := make([]*Record, 0, len(txs))
+	for _, tx := range txs {
+		if account != AllAccounts && !txInAccount(tx, account) {
+			continue
+		}
+
+		record := &Record{
+			Time:          time.Unix(tx.Timestamp, 0),
+			Wallet:        wallet.GetWalletName(),
+			Asset:         wallet.GetAssetType(),
+			Hash:          tx.Hash,
+			Type:          tx.Type,
+			Direction:     direction(tx.Direction),
+			Amount:        wallet.ToAmount(tx.Amount).ToCoin(),
+			Fee:           wallet.ToAmount(tx.Fee).ToCoin(),
+			Label:         tx.Label,
+			TicketSpender: tx.TicketSpender,
+		}
+		if tx.BlockHeight != sharedW.UnminedTxHeight {
+			record.Confirmations = bestBlock - tx.BlockHeight + 1
+		}
+
+		records = append(records, record)
+	}
+
+	if rateSource == nil || !hasMarket {
+		return records, nil
+	}
+	historicalRate := ext.HistoricalRateLookup(rateSource, market)
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		if rate, err := historicalRate(record.Time); err == nil {
+			fiatValue := record.Amount * rate
+			record.FiatValue = &fiatValue
+			record.FiatCurrency = fiatCurrency
+		}
+	}
+
+	return records, nil
+}
+
+// MissingFiatValues returns the number of records of assets with a fiat market
+// that have no fiat value, because the rate source had no rate for their time
+// or couldn't be reached.
+func MissingFiatValues(records []*Record) int {
+	var missing int
+	for _, record := range records {
+		if _, hasMarket := values.AssetExchangeMarketValue[record.Asset]; hasMarket && record.FiatValue == nil {
+			missing++
+		}
+	}
+	return missing
+}
+
+// txInAccount returns true if any input or output of tx belongs to account.
+func txInAccount(tx *sharedW.Transaction, account int32) bool {
+	for _, input := range tx.Inputs {
+		if input.AccountNumber == account {
+			return true
+		}
+	}
+	for _, output := range tx.Outputs {
+		if output.AccountNumber == account {
+			return true
+		}
+	}
+	return false
+}
+
+// direction returns the name of a tx direction. Unlike
+// txhelper.TxDirectionString, it isn't translated so exports can be processed
+// the same way whatever the language of the app.
+func direction(txDirection int32) string {
+	switch txDirection {
+	case txhelper.TxDirectionReceived:
+		return "received"
+	case txhelper.TxDirectionSent:
+		return "sent"
+	case txhelper.TxDirectionTransferred:
+		return "transferred"
+	default:
+		return strconv.Itoa(int(txDirection))
+	}
+}
+
+// Write writes records to w in the provided format.
+func Write(w io.Writer, format Format, records []*Record) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, records)
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+func writeCSV(w io.Writer, records []*Record) error {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = runtime.GOOS == "windows"
+	if err := writer.Write(csvHeaders); err != nil {
+		return fmt.Errorf("csv.Writer.Write error: %w", err)
+	}
+
+	for _, r := range records {
+		var fiatValue string
+		if r.FiatValue != nil {
+			fiatValue = strconv.FormatFloat(*r.FiatValue, 'f', 2, 64)
+		}
+		err := writer.Write([]string{
+			r.Time.UTC().Format(time.RFC3339),
+			r.Wallet,
+			r.Asset.String(),
+			r.Hash,
+			r.Type,
+			r.Direction,
+			strconv.FormatFloat(r.Amount, 'f', -1, 64),
+			strconv.FormatFloat(r.Fee, 'f', -1, 64),
+			r.Label,
+			strconv.Itoa(int(r.Confirmations)),
+			r.TicketSpender,
+			fiatValue,
+			r.FiatCurrency,
+		})
+		if err != nil {
+			return fmt.Errorf("csv.Writer.Write error: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("csv.Writer error: %w", err)
+	}
+	return nil
+}
+
+// ToFile exports the transactions of the account of each of wallets, or of all
+// their accounts if account is AllAccounts, to fileName. The file is removed if
+// the export fails. If rateSource isn't nil, the number of exported
+// transactions without a fiat value is returned, see MissingFiatValues.
+func ToFile(fileName string, format Format, wallets []sharedW.Asset, account int32, rateSource ext.RateSource) (int, error) {
+	var records []*Record
+	for _, wallet := range wallets {
+		walletRecords, err := Records(wallet, account, rateSource)
+		if err != nil {
+			return 0, err
+		}
+		records = append(records, walletRecords...)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
+		return 0, fmt.Errorf("os.MkdirAll error: %w", err)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return 0, fmt.Errorf("os.Create error: %w", err)
+	}
+
+	err = Write(f, format, records)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(fileName)
+		return 0, err
+	}
+
+	if rateSource == nil {
+		return 0, nil
+	}
+	return MissingFiatValues(records), nil
+}
@@ -0,0 +1,267 @@
+package txexport
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// testWallet implements the parts of sharedW.Asset used by Records.
+type testWallet struct {
+	sharedW.Asset
+	txs []*sharedW.Transaction
+}
+
+func (w *testWallet) GetTransactionsRaw(_, _, _ int32, _ bool, _ string) ([]*sharedW.Transaction, error) {
+	return w.txs, nil
+}
+
+func (w *testWallet) GetWalletName() string                { return "test" }
+func (w *testWallet) GetAssetType() utils.AssetType        { return utils.DCRWalletAsset }
+func (w *testWallet) GetBestBlockHeight() int32            { return 100 }
+func (w *testWallet) ToAmount(v int64) sharedW.AssetAmount { return dcr.Amount(v) }
+
+// testRateSource returns the rates of rates and records the times rates were
+// requested for. Times without a rate fail with err.
+type testRateSource struct {
+	ext.RateSource
+	rates     map[int64]float64
+	err       error
+	requested []int64
+}
+
+func (rs *testRateSource) HistoricalRate(market values.Market, at time.Time) (float64, error) {
+	rs.requested = append(rs.requested, at.Unix())
+	if market != values.DCRUSDTMarket {
+		return 0, ext.ErrHistoricalRateUnavailable
+	}
+	rate, ok := rs.rates[at.Unix()]
+	if !ok {
+		return 0, rs.err
+	}
+	return rate, nil
+}
+
+// testTxs are the transactions of the test wallet, newest first like
+// GetTransactionsRaw returns them.
+var testTxs = []*sharedW.Transaction{{
+	Hash:        "unmined",
+	Timestamp:   3000,
+	BlockHeight: sharedW.UnminedTxHeight,
+	Direction:   txhelper.TxDirectionSent,
+	Amount:      50000000,
+	Fee:         2000,
+	Inputs:      []*sharedW.TxInput{{AccountNumber: 1}},
+}, {
+	Hash:        "account0",
+	Timestamp:   2000,
+	BlockHeight: 91,
+	Direction:   txhelper.TxDirectionReceived,
+	Amount:      200000000,
+	Outputs:     []*sharedW.TxOutput{{AccountNumber: 0}},
+}, {
+	Hash:        "account1",
+	Timestamp:   1000,
+	BlockHeight: 100,
+	Direction:   txhelper.TxDirectionReceived,
+	Amount:      100000000,
+	Outputs:     []*sharedW.TxOutput{{AccountNumber: 1}},
+}}
+
+func TestRecords(t *testing.T) {
+	errRateSource := errors.New("rate source failed")
+	tests := []struct {
+		name       string
+		account    int32
+		rateSource *testRateSource
+		hashes     []string
+		// fiatValues are the expected fiat values of the records, -1 for
+		// none.
+		fiatValues []float64
+		requested  []int64
+		missing    int
+	}{{
+		name:       "all accounts without rates",
+		account:    AllAccounts,
+		hashes:     []string{"unmined", "account0", "account1"},
+		fiatValues: []float64{-1, -1, -1},
+		missing:    3,
+	}, {
+		name:       "single account",
+		account:    1,
+		rateSource: &testRateSource{rates: map[int64]float64{1000: 10, 3000: 20}},
+		hashes:     []string{"unmined", "account1"},
+		fiatValues: []float64{10, 10},
+		requested:  []int64{1000, 3000},
+	}, {
+		name:       "rates looked up oldest first",
+		account:    AllAccounts,
+		rateSource: &testRateSource{rates: map[int64]float64{1000: 10, 3000: 20}, err: ext.ErrHistoricalRateUnavailable},
+		hashes:     []string{"unmined", "account0", "account1"},
+		fiatValues: []float64{10, -1, 10},
+		requested:  []int64{1000, 2000, 3000},
+		missing:    1,
+	}, {
+		name:       "rate source failure stops lookups",
+		account:    AllAccounts,
+		rateSource: &testRateSource{rates: map[int64]float64{1000: 10, 3000: 20}, err: errRateSource},
+		hashes:     []string{"unmined", "account0", "account1"},
+		fiatValues: []float64{-1, -1, 10},
+		requested:  []int64{1000, 2000},
+		missing:    2,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rateSource ext.RateSource
+			if test.rateSource != nil {
+				rateSource = test.rateSource
+			}
+			records, err := Records(&testWallet{txs: testTxs}, test.account, rateSource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(records) != len(test.hashes) {
+				t.Fatalf("expected %d records, got %d", len(test.hashes), len(records))
+			}
+			for i, record := range records {
+				if record.Hash != test.hashes[i] {
+					t.Fatalf("expected record %d to be %s, got %s", i, test.hashes[i], record.Hash)
+				}
+				fiatValue := -1.0
+				if record.FiatValue != nil {
+					fiatValue = *record.FiatValue
+				}
+				if fiatValue != test.fiatValues[i] {
+					t.Fatalf("expected fiat value %v for %s, got %v", test.fiatValues[i], record.Hash, fiatValue)
+				}
+			}
+			if missing := MissingFiatValues(records); missing != test.missing {
+				t.Fatalf("expected %d records without a fiat value, got %d", test.missing, missing)
+			}
+			if test.rateSource != nil && !equalInt64s(test.rateSource.requested, test.requested) {
+				t.Fatalf("expected rates requested for %v, got %v", test.requested, test.rateSource.requested)
+			}
+		})
+	}
+
+	records, err := Records(&testWallet{txs: testTxs}, AllAccounts, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if records[0].Confirmations != 0 || records[1].Confirmations != 10 || records[2].Confirmations != 1 {
+		t.Fatalf("unexpected confirmations %d, %d, %d", records[0].Confirmations, records[1].Confirmations, records[2].Confirmations)
+	}
+	if records[0].Direction != "sent" || records[0].Amount != 0.5 || records[0].Fee != 0.00002 {
+		t.Fatalf("unexpected record %+v", records[0])
+	}
+}
+
+func TestWrite(t *testing.T) {
+	fiatValue := 12.345
+	records := []*Record{{
+		Time:          time.Unix(1700000000, 0),
+		Wallet:        "savings",
+		Asset:         utils.DCRWalletAsset,
+		Hash:          "abc",
+		Type:          "regular",
+		Direction:     "received",
+		Amount:        1.5,
+		Label:         "rent, march",
+		Confirmations: 6,
+		FiatValue:     &fiatValue,
+		FiatCurrency:  "USDT",
+	}, {
+		Time:      time.Unix(1700003600, 0),
+		Wallet:    "savings",
+		Asset:     utils.DCRWalletAsset,
+		Hash:      "def",
+		Type:      "regular",
+		Direction: "sent",
+		Amount:    0.25,
+		Fee:       0.0001,
+	}}
+
+	tests := []struct {
+		name    string
+		format  Format
+		records []*Record
+		want    string
+		wantErr bool
+	}{{
+		name:    "csv",
+		format:  CSV,
+		records: records,
+		want: "time,wallet,asset,hash,type,direction,amount,fee,label,confirmations,ticket_spender,fiat_value,fiat_currency\n" +
+			"2023-11-14T22:13:20Z,savings,DCR,abc,regular,received,1.5,0,\"rent, march\",6,,12.35,USDT\n" +
+			"2023-11-14T23:13:20Z,savings,DCR,def,regular,sent,0.25,0.0001,,0,,,\n",
+	}, {
+		name:    "csv without records",
+		format:  CSV,
+		records: nil,
+		want:    "time,wallet,asset,hash,type,direction,amount,fee,label,confirmations,ticket_spender,fiat_value,fiat_currency\n",
+	}, {
+		name:    "json",
+		format:  JSON,
+		records: records[1:],
+		want: "[\n" +
+			"  {\n" +
+			"    \"time\": \"" + records[1].Time.Format(time.RFC3339) + "\",\n" +
+			"    \"wallet\": \"savings\",\n" +
+			"    \"asset\": \"DCR\",\n" +
+			"    \"hash\": \"def\",\n" +
+			"    \"type\": \"regular\",\n" +
+			"    \"direction\": \"sent\",\n" +
+			"    \"amount\": 0.25,\n" +
+			"    \"fee\": 0.0001,\n" +
+			"    \"confirmations\": 0\n" +
+			"  }\n" +
+			"]\n",
+	}, {
+		name:    "unsupported format",
+		format:  Format("xml"),
+		records: records,
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.format == CSV && runtime.GOOS == "windows" {
+				t.Skip("CSV lines end with CRLF on windows")
+			}
+			var buf bytes.Buffer
+			err := Write(&buf, test.format, test.records)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if buf.String() != test.want {
+				t.Fatalf("expected\n%s\ngot\n%s", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -41,13 +41,14 @@ func MakeTxChangeSource(destAddr string, net dcrutil.AddressParams) (*TxChangeSo
 }
 
 func MakeBTCTxChangeSource(destAddr string, net *btccfg.Params) (*btctxauthor.ChangeSource, error) {
-	var pkScript []byte
+	// The script is derived upfront so that ScriptSize is known when
+	// txauthor estimates the size of the change output.
+	pkScript, err := addresshelper.BTCPkScript(destAddr, net)
+	if err != nil {
+		return nil, err
+	}
 	changeSource := &btctxauthor.ChangeSource{
 		NewScript: func() ([]byte, error) {
-			pkScript, err := addresshelper.BTCPkScript(destAddr, net)
-			if err != nil {
-				return nil, err
-			}
 			return pkScript, nil
 		},
 		ScriptSize: len(pkScript),
@@ -56,16 +57,41 @@ func MakeBTCTxChangeSource(destAddr string, net *btccfg.Params) (*btctxauthor.Ch
 }
 
 func MakeLTCTxChangeSource(destAddr string, net *ltccfg.Params) (*ltctxauthor.ChangeSource, error) {
-	var pkScript []byte
+	// The script is derived upfront so that ScriptSize is known when
+	// txauthor estimates the size of the change output.
+	pkScript, err := addresshelper.LTCPkScript(destAddr, net)
+	if err != nil {
+		return nil, err
+	}
 	changeSource := &ltctxauthor.ChangeSource{
 		NewScript: func() ([]byte, error) {
-			pkScript, err := addresshelper.LTCPkScript(destAddr, net)
-			if err != nil {
-				return nil, err
-			}
 			return pkScript, nil
 		},
 		ScriptSize: len(pkScript),
 	}
 	return changeSource, nil
 }
+
+// MakeBTCTxPlaceholderChangeSource returns a change source paying to a zeroed
+// script of scriptSize bytes. It lets fees be estimated without deriving a
+// change address, which would use up an address of the wallet on every
+// estimate. Transactions built with it must not be broadcast.
+func MakeBTCTxPlaceholderChangeSource(scriptSize int) *btctxauthor.ChangeSource {
+	return &btctxauthor.ChangeSource{
+		NewScript: func() ([]byte, error) {
+			return make([]byte, scriptSize), nil
+		},
+		ScriptSize: scriptSize,
+	}
+}
+
+// MakeLTCTxPlaceholderChangeSource is the LTC version of
+// MakeBTCTxPlaceholderChangeSource.
+func MakeLTCTxPlaceholderChangeSource(scriptSize int) *ltctxauthor.ChangeSource {
+	return &ltctxauthor.ChangeSource{
+		NewScript: func() ([]byte, error) {
+			return make([]byte, scriptSize), nil
+		},
+		ScriptSize: scriptSize,
+	}
+}
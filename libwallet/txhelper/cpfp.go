@@ -0,0 +1,37 @@
+package txhelper
+
+// feeForSize returns the fee for a transaction of size bytes paying
+// feeRatePerkvB, rounded up so the rate is never undershot.
+func feeForSize(feeRatePerkvB int64, size int) int64 {
+	return (feeRatePerkvB*int64(size) + 999) / 1000
+}
+
+// CPFPChildFee returns the fee a child transaction of childSize bytes must pay
+// so that it and its unconfirmed parent of parentSize bytes, which pays
+// parentFee, together pay feeRatePerkvB. The child never pays less than the
+// target rate for its own size. Sizes are in virtual bytes for segwit assets.
+func CPFPChildFee(parentFee int64, parentSize, childSize int, feeRatePerkvB int64) int64 {
+	childFee := feeForSize(feeRatePerkvB, parentSize+childSize) - parentFee
+	if minFee := feeForSize(feeRatePerkvB, childSize); childFee < minFee {
+		childFee = minFee
+	}
+	return childFee
+}
+
+// CPFPChildFeeRate returns the rate per kvB a child transaction of childSize
+// bytes must use to pay childFee.
+func CPFPChildFeeRate(childFee int64, childSize int) int64 {
+	if childSize == 0 {
+		return 0
+	}
+	return (childFee*1000 + int64(childSize) - 1) / int64(childSize)
+}
+
+// PackageFeeRate returns the fee rate per kvB paid by a package of
+// transactions with the combined fee and size.
+func PackageFeeRate(totalFee int64, totalSize int) int64 {
+	if totalSize == 0 {
+		return 0
+	}
+	return totalFee * 1000 / int64(totalSize)
+}
@@ -0,0 +1,109 @@
+package txhelper
+
+import "testing"
+
+func TestCPFPChildFee(t *testing.T) {
+	tests := []struct {
+		name          string
+		parentFee     int64
+		parentSize    int
+		childSize     int
+		feeRatePerkvB int64
+		want          int64
+	}{
+		{
+			name:          "child tops up the package",
+			parentFee:     1000,
+			parentSize:    200,
+			childSize:     110,
+			feeRatePerkvB: 20000,
+			want:          5200,
+		},
+		{
+			name:          "unknown parent fee, child pays for the package",
+			parentFee:     0,
+			parentSize:    200,
+			childSize:     110,
+			feeRatePerkvB: 20000,
+			want:          6200,
+		},
+		{
+			name:          "parent already pays the rate, child pays its own size",
+			parentFee:     10000,
+			parentSize:    200,
+			childSize:     110,
+			feeRatePerkvB: 20000,
+			want:          2200,
+		},
+		{
+			name:          "fee is rounded up",
+			parentFee:     0,
+			parentSize:    1,
+			childSize:     2,
+			feeRatePerkvB: 1001,
+			want:          4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := CPFPChildFee(tc.parentFee, tc.parentSize, tc.childSize, tc.feeRatePerkvB)
+			if got != tc.want {
+				t.Fatalf("expected child fee %d, got %d", tc.want, got)
+			}
+
+			packageRate := PackageFeeRate(tc.parentFee+got, tc.parentSize+tc.childSize)
+			if packageRate < tc.feeRatePerkvB {
+				t.Fatalf("package pays %d per kvB, below the target %d", packageRate, tc.feeRatePerkvB)
+			}
+
+			// The rate the child is built with must yield at least its fee.
+			childRate := CPFPChildFeeRate(got, tc.childSize)
+			if fee := feeForSize(childRate, tc.childSize); fee < got {
+				t.Fatalf("child rate %d pays %d, below the child fee %d", childRate, fee, got)
+			}
+		})
+	}
+}
+
+func TestCPFPChildFeeRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		childFee  int64
+		childSize int
+		want      int64
+	}{
+		{name: "exact", childFee: 2200, childSize: 110, want: 20000},
+		{name: "rounded up", childFee: 5200, childSize: 110, want: 47273},
+		{name: "zero size", childFee: 5200, childSize: 0, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CPFPChildFeeRate(tc.childFee, tc.childSize); got != tc.want {
+				t.Fatalf("expected child fee rate %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPackageFeeRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		totalFee  int64
+		totalSize int
+		want      int64
+	}{
+		{name: "exact", totalFee: 6200, totalSize: 310, want: 20000},
+		{name: "rounded down", totalFee: 1000, totalSize: 3, want: 333333},
+		{name: "zero size", totalFee: 999, totalSize: 0, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PackageFeeRate(tc.totalFee, tc.totalSize); got != tc.want {
+				t.Fatalf("expected package fee rate %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package txhelper
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	btcwire "github.com/btcsuite/btcd/wire"
+	btctxauthor "github.com/btcsuite/btcwallet/wallet/txauthor"
+	ltctxauthor "github.com/dcrlabs/ltcwallet/wallet/txauthor"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	ltcwire "github.com/ltcsuite/ltcd/wire"
+)
+
+// MakeBTCTxInputSource returns an InputSource that always spends all the
+// provided inputs, regardless of the target amount. It is used when the
+// inputs to spend are fixed, e.g. when bumping the fee of a transaction by
+// spending its outputs.
+func MakeBTCTxInputSource(inputs []*btcwire.TxIn, values []btcutil.Amount, pkScripts [][]byte) btctxauthor.InputSource {
+	var total btcutil.Amount
+	for _, value := range values {
+		total += value
+	}
+	return func(btcutil.Amount) (btcutil.Amount, []*btcwire.TxIn, []btcutil.Amount, [][]byte, error) {
+		return total, inputs, values, pkScripts, nil
+	}
+}
+
+// MakeLTCTxInputSource is the LTC counterpart of MakeBTCTxInputSource.
+func MakeLTCTxInputSource(inputs []*ltcwire.TxIn, values []ltcutil.Amount, pkScripts [][]byte) ltctxauthor.InputSource {
+	var total ltcutil.Amount
+	for _, value := range values {
+		total += value
+	}
+	return func(ltcutil.Amount) (ltcutil.Amount, []*ltcwire.TxIn, []ltcutil.Amount, [][]byte, error) {
+		return total, inputs, values, pkScripts, nil
+	}
+}
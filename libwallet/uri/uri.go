@@ -0,0 +1,175 @@
+// Package uri parses and builds payment request URIs as described in BIP-21,
+// e.g. bitcoin:<address>?amount=<amount>&label=<label>&message=<message>. The
+// same format is used for Litecoin and Decred with the litecoin: and decred:
+// schemes.
+package uri
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// atomsPerCoin is the number of the smallest units in a coin. It's the same
+// for all the supported assets.
+const atomsPerCoin = 1e8
+
+// maxDecimals is the number of decimal places an amount may have.
+const maxDecimals = 8
+
+var schemes = map[utils.AssetType]string{
+	utils.BTCWalletAsset: "bitcoin",
+	utils.LTCWalletAsset: "litecoin",
+	utils.DCRWalletAsset: "decred",
+}
+
+// PaymentURI is a request for a payment to an address.
+type PaymentURI struct {
+	AssetType utils.AssetType
+	Address   string
+	// Amount is the requested amount in atoms (satoshis for BTC and LTC).
+	// It's zero if no amount is requested.
+	Amount  int64
+	Label   string
+	Message string
+}
+
+// Scheme returns the URI scheme used for assetType.
+func Scheme(assetType utils.AssetType) (string, error) {
+	scheme, ok := schemes[assetType]
+	if !ok {
+		return "", fmt.Errorf("payment URIs are not supported for %v", assetType)
+	}
+	return scheme, nil
+}
+
+// IsPaymentURI returns true if s starts with the scheme of a supported asset.
+// It doesn't check that the rest of s is valid.
+func IsPaymentURI(s string) bool {
+	scheme, _, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found {
+		return false
+	}
+	for _, supported := range schemes {
+		if strings.EqualFold(scheme, supported) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse decodes the payment URI s. The address is not validated against any
+// network's parameters. As required by BIP-21, URIs with parameters prefixed
+// with "req-" are rejected since their meaning isn't known.
+func Parse(s string) (*PaymentURI, error) {
+	scheme, rest, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found {
+		return nil, fmt.Errorf("invalid payment URI: missing scheme")
+	}
+
+	paymentURI := new(PaymentURI)
+	for assetType, supported := range schemes {
+		if strings.EqualFold(scheme, supported) {
+			paymentURI.AssetType = assetType
+			break
+		}
+	}
+	if paymentURI.AssetType == "" {
+		return nil, fmt.Errorf("unsupported payment URI scheme %q", scheme)
+	}
+
+	// Some wallets write the address like the host of a URL.
+	rest = strings.TrimPrefix(rest, "//")
+	address, query, _ := strings.Cut(rest, "?")
+	paymentURI.Address = strings.TrimSuffix(address, "/")
+	if paymentURI.Address == "" {
+		return nil, fmt.Errorf("invalid payment URI: missing address")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment URI parameters: %v", err)
+	}
+	for key, values := range params {
+		value := values[0]
+		switch key {
+		case "amount":
+			paymentURI.Amount, err = ParseAmount(value)
+			if err != nil {
+				return nil, err
+			}
+		case "label":
+			paymentURI.Label = value
+		case "message":
+			paymentURI.Message = value
+		default:
+			if strings.HasPrefix(key, "req-") {
+				return nil, fmt.Errorf("unsupported required payment URI parameter %q", key)
+			}
+		}
+	}
+
+	return paymentURI, nil
+}
+
+// String returns the URI encoding of the payment request. Parameters are only
+// included if they're set.
+func (p *PaymentURI) String() string {
+	scheme, err := Scheme(p.AssetType)
+	if err != nil {
+		return p.Address
+	}
+
+	var params []string
+	if p.Amount > 0 {
+		params = append(params, "amount="+formatAmount(p.Amount))
+	}
+	if p.Label != "" {
+		params = append(params, "label="+escape(p.Label))
+	}
+	if p.Message != "" {
+		params = append(params, "message="+escape(p.Message))
+	}
+
+	s := scheme + ":" + p.Address
+	if len(params) > 0 {
+		s += "?" + strings.Join(params, "&")
+	}
+	return s
+}
+
+// ParseAmount converts a decimal amount in coins, as used in payment URIs, to
+// atoms without going through a float, so no precision is lost.
+func ParseAmount(s string) (int64, error) {
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" || len(fraction) > maxDecimals {
+		return 0, fmt.Errorf("invalid payment URI amount %q", s)
+	}
+	for _, r := range whole + fraction {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid payment URI amount %q", s)
+		}
+	}
+
+	fraction += strings.Repeat("0", maxDecimals-len(fraction))
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid payment URI amount %q", s)
+	}
+	return amount, nil
+}
+
+// formatAmount is the inverse of ParseAmount. Trailing zeros are dropped.
+func formatAmount(atoms int64) string {
+	s := fmt.Sprintf("%d.%08d", atoms/atomsPerCoin, atoms%atomsPerCoin)
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
+
+// escape percent-encodes s for use in a parameter value. Spaces are encoded as
+// %20 rather than + since not all wallets decode the latter.
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,141 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    *PaymentURI
+		wantErr bool
+	}{{
+		name: "address only",
+		uri:  "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		want: &PaymentURI{
+			AssetType: utils.BTCWalletAsset,
+			Address:   "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		},
+	}, {
+		name: "all parameters",
+		uri:  "decred:DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg?amount=1.5&label=Shop&message=Order%2042",
+		want: &PaymentURI{
+			AssetType: utils.DCRWalletAsset,
+			Address:   "DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg",
+			Amount:    150000000,
+			Label:     "Shop",
+			Message:   "Order 42",
+		},
+	}, {
+		name: "uppercase scheme and host-like address",
+		uri:  "LITECOIN://ltc1qg82tcvcg0fkz7s2cn6lw3stq8xzqw0lyxjzxdh/?amount=.00000001",
+		want: &PaymentURI{
+			AssetType: utils.LTCWalletAsset,
+			Address:   "ltc1qg82tcvcg0fkz7s2cn6lw3stq8xzqw0lyxjzxdh",
+			Amount:    1,
+		},
+	}, {
+		name: "unknown optional parameter",
+		uri:  "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?somethingelse=1",
+		want: &PaymentURI{
+			AssetType: utils.BTCWalletAsset,
+			Address:   "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		},
+	}, {
+		name:    "bad scheme",
+		uri:     "ethereum:0x0000000000000000000000000000000000000000",
+		wantErr: true,
+	}, {
+		name:    "missing scheme",
+		uri:     "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		wantErr: true,
+	}, {
+		name:    "missing address",
+		uri:     "bitcoin:?amount=1",
+		wantErr: true,
+	}, {
+		name:    "negative amount",
+		uri:     "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?amount=-1",
+		wantErr: true,
+	}, {
+		name:    "too many decimals",
+		uri:     "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?amount=0.000000001",
+		wantErr: true,
+	}, {
+		name:    "exponent amount",
+		uri:     "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?amount=1e3",
+		wantErr: true,
+	}, {
+		name:    "unknown required parameter",
+		uri:     "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?req-somethingelse=1",
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.uri)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *got != *test.want {
+				t.Fatalf("expected %+v, got %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPaymentURIRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		paymentURI *PaymentURI
+		uri        string
+	}{{
+		name: "address only",
+		paymentURI: &PaymentURI{
+			AssetType: utils.BTCWalletAsset,
+			Address:   "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		},
+		uri: "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+	}, {
+		name: "whole amount",
+		paymentURI: &PaymentURI{
+			AssetType: utils.LTCWalletAsset,
+			Address:   "ltc1qg82tcvcg0fkz7s2cn6lw3stq8xzqw0lyxjzxdh",
+			Amount:    200000000,
+		},
+		uri: "litecoin:ltc1qg82tcvcg0fkz7s2cn6lw3stq8xzqw0lyxjzxdh?amount=2",
+	}, {
+		name: "all parameters",
+		paymentURI: &PaymentURI{
+			AssetType: utils.DCRWalletAsset,
+			Address:   "DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg",
+			Amount:    12345678,
+			Label:     "Coffee & cake",
+			Message:   "Table 4",
+		},
+		uri: "decred:DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg?amount=0.12345678&label=Coffee%20%26%20cake&message=Table%204",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uri := test.paymentURI.String()
+			if uri != test.uri {
+				t.Fatalf("expected %s, got %s", test.uri, uri)
+			}
+			parsed, err := Parse(uri)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *parsed != *test.paymentURI {
+				t.Fatalf("expected %+v, got %+v", test.paymentURI, parsed)
+			}
+		})
+	}
+}
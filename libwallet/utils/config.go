@@ -28,6 +28,7 @@ const (
 	ProposalStatusNewProposal
 	ProposalStatusVoteStarted
 	ProposalStatusVoteFinished
+	ProposalStatusEdited
 )
 
 type (
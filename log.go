@@ -18,6 +18,7 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/logger"
+	"github.com/crypto-power/cryptopower/rpcserver"
 	"github.com/crypto-power/cryptopower/ui"
 	"github.com/crypto-power/cryptopower/ui/load"
 	"github.com/crypto-power/cryptopower/ui/modal"
@@ -101,6 +102,7 @@ var (
 	extLog       = backendLog.Logger("EXT")
 	amgrLog      = backendLog.Logger("AMGR")
 	cmgrLog      = backendLog.Logger("CMGR")
+	rpcsLog      = backendLog.Logger("RPCS")
 	dcrLog       = dcrBackendLog.Logger("DCR")
 	syncLog      = dcrBackendLog.Logger("SYNC")
 	tkbyLog      = dcrBackendLog.Logger("TKBY")
@@ -151,6 +153,7 @@ func init() {
 	account.UseLogger(winLog)
 	wallet.UseLogger(winLog)
 	receive.UseLogger(winLog)
+	rpcserver.UseLogger(rpcsLog)
 
 	logger.New(subsystemSLoggers, subsystemBLoggers)
 	// Neutrino loglevel will always be set to error to control excessive logging.
@@ -169,6 +172,7 @@ var subsystemSLoggers = map[string]slog.Logger{
 	"EXT":  extLog,
 	"AMGR": amgrLog,
 	"CMGR": cmgrLog,
+	"RPCS": rpcsLog,
 	"SYNC": syncLog,
 	"TKBY": tkbyLog,
 	"WLLT": dcrWalletLog,
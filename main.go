@@ -93,6 +93,15 @@ func main() {
 		return
 	}
 
+	// In headless mode the wallets are served over JSON-RPC and the GUI is
+	// never started.
+	if cfg.Headless {
+		if err := runHeadless(cfg, appCfg, initializeAssetsManager); err != nil {
+			log.Errorf("headless mode error: %v", err)
+		}
+		return
+	}
+
 	// Init the AssetsManager using the user-selected netType or mainnet if the
 	// user has not selected a netType from the app's settings page.
 	appInfo, err := load.StartApp(Version, buildDate, cfg.Network, appCfg, initializeAssetsManager)
@@ -101,6 +110,8 @@ func main() {
 		return
 	}
 
+	appInfo.SetPaymentURI(cfg.paymentURI)
+
 	win, err := ui.CreateWindow(appInfo)
 	if err != nil {
 		log.Errorf("Could not initialize window: %s\ns", err)
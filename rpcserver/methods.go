@@ -0,0 +1,641 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// defaultTxLimit is the number of transactions returned by listtransactions
+// when no limit is provided.
+const defaultTxLimit = 50
+
+type handlerFunc func(ctx context.Context, params json.RawMessage) (interface{}, error)
+
+func (s *Server) registerHandlers() map[string]handlerFunc {
+	return map[string]handlerFunc{
+		"listmethods":      s.listMethods,
+		"openwallets":      s.openWallets,
+		"listwallets":      s.listWallets,
+		"startsync":        s.startSync,
+		"syncstatus":       s.syncStatus,
+		"listaccounts":     s.listAccounts,
+		"getbalance":       s.getBalance,
+		"getaddress":       s.getAddress,
+		"validateaddress":  s.validateAddress,
+		"listtransactions": s.listTransactions,
+		"gettransaction":   s.getTransaction,
+		"estimatefee":      s.estimateFee,
+		"send":             s.send,
+		"publishunmined":   s.publishUnmined,
+		"createpsbt":       s.createPSBT,
+		"broadcastpsbt":    s.broadcastPSBT,
+
+		"exportunsignedtx":  s.exportUnsignedTx,
+		"signexternaltx":    s.signExternalTx,
+		"broadcastsignedtx": s.broadcastSignedTx,
+	}
+}
+
+func (s *Server) listMethods(_ context.Context, _ json.RawMessage) (interface{}, error) {
+	methods := make([]string, 0, len(s.handlers))
+	for method := range s.handlers {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods, nil
+}
+
+// walletWithID returns the loaded wallet with the provided ID.
+func (s *Server) walletWithID(walletID int) (sharedW.Asset, error) {
+	wallet := s.mgr.WalletWithID(walletID)
+	if wallet == nil {
+		return nil, newError(ErrCodeInvalidParams, fmt.Sprintf("wallet %d does not exist", walletID))
+	}
+	return wallet, nil
+}
+
+// openedWalletWithID is like walletWithID but also requires the wallet to
+// have been opened.
+func (s *Server) openedWalletWithID(walletID int) (sharedW.Asset, error) {
+	wallet, err := s.walletWithID(walletID)
+	if err != nil {
+		return nil, err
+	}
+	if !wallet.WalletOpened() {
+		return nil, newError(ErrCodeWallet, fmt.Sprintf("wallet %d is not open, call openwallets first", walletID))
+	}
+	return wallet, nil
+}
+
+func (s *Server) openWallets(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p openWalletsParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	if err := s.OpenWallets(p.StartupPassphrase); err != nil {
+		return nil, err
+	}
+	return s.mgr.OpenedWalletsCount(), nil
+}
+
+// OpenWallets opens all the wallets loaded by the assets manager and starts
+// syncing those that can sync without being unlocked. Wallets that still need
+// to complete account discovery must be synced with the startsync method.
+func (s *Server) OpenWallets(startupPassphrase string) error {
+	if err := s.mgr.OpenWallets(startupPassphrase); err != nil {
+		return err
+	}
+
+	for _, wallet := range s.mgr.AllWallets() {
+		if !wallet.WalletOpened() || wallet.IsSynced() || wallet.IsSyncing() {
+			continue
+		}
+		if !wallet.ContainsDiscoveredAccounts() && wallet.IsLocked() && !wallet.IsWatchingOnlyWallet() {
+			log.Infof("Wallet %d (%s) needs its passphrase to resume account discovery, call startsync to sync it",
+				wallet.GetWalletID(), wallet.GetWalletName())
+			continue
+		}
+		if err := wallet.SpvSync(); err != nil {
+			log.Errorf("Error starting sync for wallet %d: %v", wallet.GetWalletID(), err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) listWallets(_ context.Context, _ json.RawMessage) (interface{}, error) {
+	wallets := s.mgr.AllWallets()
+	res := make([]*WalletResult, 0, len(wallets))
+	for _, wallet := range wallets {
+		opened := wallet.WalletOpened()
+		wr := &WalletResult{
+			ID:            wallet.GetWalletID(),
+			Name:          wallet.GetWalletName(),
+			Asset:         wallet.GetAssetType().String(),
+			Opened:        opened,
+			Confirmations: wallet.RequiredConfirmations(),
+		}
+		if opened {
+			wr.WatchOnly = wallet.IsWatchingOnlyWallet()
+			wr.BackedUp = wallet.IsWalletBackedUp()
+			wr.Synced = wallet.IsSynced()
+			wr.Syncing = wallet.IsSyncing()
+			if wr.Synced {
+				wr.BestBlock = wallet.GetBestBlockHeight()
+			}
+		}
+		res = append(res, wr)
+	}
+	return res, nil
+}
+
+func (s *Server) startSync(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p startSyncParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wallet.IsSynced() || wallet.IsSyncing() {
+		return true, nil
+	}
+
+	// Wallets that have not completed account discovery need to be unlocked
+	// for the discovery to resume.
+	if !wallet.ContainsDiscoveredAccounts() && wallet.IsLocked() && !wallet.IsWatchingOnlyWallet() {
+		if p.Passphrase == "" {
+			return nil, newError(ErrCodeInvalidParams, "passphrase is required to resume account discovery")
+		}
+		if err := wallet.UnlockWallet(p.Passphrase); err != nil {
+			return nil, err
+		}
+		if err := lockAfterSync(wallet); err != nil {
+			wallet.LockWallet()
+			return nil, err
+		}
+	}
+
+	if err := wallet.SpvSync(); err != nil {
+		wallet.LockWallet()
+		wallet.RemoveSyncProgressListener(discoveryLockListenerID)
+		return nil, err
+	}
+	return true, nil
+}
+
+// discoveryLockListenerID identifies the sync progress listener that locks a
+// wallet unlocked for account discovery.
+const discoveryLockListenerID = "rpcserver-discovery-lock"
+
+// lockAfterSync locks the wallet once the sync, and with it the account
+// discovery, completes, is canceled or fails.
+func lockAfterSync(wallet sharedW.Asset) error {
+	lock := func() {
+		wallet.LockWallet()
+		// The listener can't be removed while the listeners are notified.
+		go wallet.RemoveSyncProgressListener(discoveryLockListenerID)
+	}
+	wallet.RemoveSyncProgressListener(discoveryLockListenerID)
+	return wallet.AddSyncProgressListener(&sharedW.SyncProgressListener{
+		OnSyncCompleted:      lock,
+		OnSyncCanceled:       func(bool) { lock() },
+		OnSyncEndedWithError: func(error) { lock() },
+	}, discoveryLockListenerID)
+}
+
+func (s *Server) syncStatus(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		WalletID *int `json:"walletid"`
+	}
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	var wallets []sharedW.Asset
+	if p.WalletID != nil {
+		wallet, err := s.openedWalletWithID(*p.WalletID)
+		if err != nil {
+			return nil, err
+		}
+		wallets = []sharedW.Asset{wallet}
+	} else {
+		for _, wallet := range s.mgr.AllWallets() {
+			if wallet.WalletOpened() {
+				wallets = append(wallets, wallet)
+			}
+		}
+	}
+
+	res := make([]*SyncStatusResult, 0, len(wallets))
+	for _, wallet := range wallets {
+		status := &SyncStatusResult{
+			WalletID:   wallet.GetWalletID(),
+			Asset:      wallet.GetAssetType().String(),
+			Synced:     wallet.IsSynced(),
+			Syncing:    wallet.IsSyncing(),
+			Rescanning: wallet.IsRescanning(),
+		}
+		if status.Synced || status.Syncing {
+			status.ConnectedPeers = wallet.ConnectedPeers()
+			bestBlock := wallet.GetBestBlock()
+			status.BestBlockHeight = bestBlock.Height
+			status.BestBlockTime = bestBlock.Timestamp
+		}
+		res = append(res, status)
+	}
+	return res, nil
+}
+
+func (s *Server) listAccounts(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p walletParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := wallet.GetAccountsRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*AccountResult, 0, len(accounts.Accounts))
+	for _, account := range accounts.Accounts {
+		res = append(res, &AccountResult{
+			Number:  account.Number,
+			Name:    account.Name,
+			Balance: balanceResult(account.Balance),
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) getBalance(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p getBalanceParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	var balance *sharedW.Balance
+	if p.Account != nil {
+		balance, err = wallet.GetAccountBalance(*p.Account)
+	} else {
+		balance, err = wallet.GetWalletBalance()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return balanceResult(balance), nil
+}
+
+func (s *Server) getAddress(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p getAddressParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.New {
+		return wallet.NextAddress(p.Account)
+	}
+	return wallet.CurrentAddress(p.Account)
+}
+
+func (s *Server) validateAddress(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		WalletID int    `json:"walletid"`
+		Address  string `json:"address"`
+	}
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	isValid := wallet.IsAddressValid(p.Address)
+	return map[string]bool{
+		"isvalid": isValid,
+		"ismine":  isValid && wallet.HaveAddress(p.Address),
+	}, nil
+}
+
+func (s *Server) listTransactions(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p listTransactionsParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = defaultTxLimit
+	}
+	newestFirst := true
+	if p.NewestFirst != nil {
+		newestFirst = *p.NewestFirst
+	}
+
+	txs, err := wallet.GetTransactionsRaw(p.Offset, p.Limit, p.Filter, newestFirst, p.Search)
+	if err != nil {
+		return nil, err
+	}
+	if txs == nil {
+		txs = []*sharedW.Transaction{}
+	}
+	return txs, nil
+}
+
+func (s *Server) getTransaction(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p getTransactionParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.GetTransactionRaw(p.Hash)
+}
+
+// prepareSend sets up the wallet's unsigned transaction using the provided
+// source account and destinations. Watch-only wallets are rejected unless
+// allowWatchOnly is set. Must be called with sendMtx held.
+func (s *Server) prepareSend(p *sendParams, allowWatchOnly bool) (sharedW.Asset, error) {
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	if wallet.IsWatchingOnlyWallet() && !allowWatchOnly {
+		return nil, newError(ErrCodeWallet, utils.ErrWalletIsWatchOnly)
+	}
+	if len(p.Destinations) == 0 {
+		return nil, newError(ErrCodeInvalidParams, "at least one destination is required")
+	}
+
+	if err := wallet.NewUnsignedTx(p.Account, nil); err != nil {
+		return nil, err
+	}
+	for i, dest := range p.Destinations {
+		if err := wallet.AddSendDestination(i, dest.Address, dest.Amount, dest.SendMax); err != nil {
+			return nil, fmt.Errorf("destination %d: %w", i, err)
+		}
+	}
+	return wallet, nil
+}
+
+func (s *Server) estimateFee(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p sendParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	s.sendMtx.Lock()
+	defer s.sendMtx.Unlock()
+
+	wallet, err := s.prepareSend(&p, false)
+	if err != nil {
+		return nil, err
+	}
+
+	feeAndSize, err := wallet.EstimateFeeAndSize()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &FeeResult{
+		FeeRate:       feeAndSize.FeeRate,
+		EstimatedSize: feeAndSize.EstimatedSignedSize,
+	}
+	if feeAndSize.Fee != nil {
+		res.Fee = AmountResult{Atoms: feeAndSize.Fee.UnitValue, Coins: feeAndSize.Fee.CoinValue}
+	}
+	if feeAndSize.Change != nil {
+		res.Change = &AmountResult{Atoms: feeAndSize.Change.UnitValue, Coins: feeAndSize.Change.CoinValue}
+	}
+	return res, nil
+}
+
+func (s *Server) send(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p sendParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Passphrase == "" {
+		return nil, newError(ErrCodeInvalidParams, "passphrase is required")
+	}
+
+	s.sendMtx.Lock()
+	defer s.sendMtx.Unlock()
+
+	wallet, err := s.prepareSend(&p, false)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := wallet.Broadcast(p.Passphrase, p.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Broadcast tx %s from wallet %d", txHash, p.WalletID)
+	return txHash, nil
+}
+
+func (s *Server) publishUnmined(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p walletParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.openedWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wallet.PublishUnminedTransactions(); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// psbtWallet is implemented by the assets that support BIP-174 partially
+// signed transactions.
+type psbtWallet interface {
+	ExportUnsignedPSBT() (string, error)
+	BroadcastPSBT(data []byte, privatePassphrase, transactionLabel string) (string, error)
+}
+
+func (s *Server) psbtWalletWithID(walletID int) (psbtWallet, error) {
+	wallet, err := s.openedWalletWithID(walletID)
+	if err != nil {
+		return nil, err
+	}
+	psbtW, ok := wallet.(psbtWallet)
+	if !ok {
+		return nil, newError(ErrCodeWallet, fmt.Sprintf("%s wallets do not support PSBTs", wallet.GetAssetType()))
+	}
+	return psbtW, nil
+}
+
+func (s *Server) createPSBT(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p sendParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	s.sendMtx.Lock()
+	defer s.sendMtx.Unlock()
+
+	psbtW, err := s.psbtWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.prepareSend(&p, true); err != nil {
+		return nil, err
+	}
+	return psbtW.ExportUnsignedPSBT()
+}
+
+func (s *Server) broadcastPSBT(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p broadcastPSBTParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.PSBT == "" {
+		return nil, newError(ErrCodeInvalidParams, "psbt is required")
+	}
+
+	psbtW, err := s.psbtWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := psbtW.BroadcastPSBT([]byte(p.PSBT), p.Passphrase, p.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Broadcast PSBT tx %s from wallet %d", txHash, p.WalletID)
+	return txHash, nil
+}
+
+// offlineSigningWallet is implemented by the assets that move unsigned
+// transactions between a watch-only and a seeded wallet in their own format.
+type offlineSigningWallet interface {
+	ExportUnsignedTransaction() ([]byte, error)
+	SignExternalTransaction(unsignedTxData []byte, privatePassphrase string) (string, error)
+	BroadcastSignedTransaction(signedTxHex, transactionLabel string) (string, error)
+}
+
+func (s *Server) offlineSigningWalletWithID(walletID int) (offlineSigningWallet, error) {
+	wallet, err := s.openedWalletWithID(walletID)
+	if err != nil {
+		return nil, err
+	}
+	offlineW, ok := wallet.(offlineSigningWallet)
+	if !ok {
+		return nil, newError(ErrCodeWallet, fmt.Sprintf("%s wallets do not support offline signing", wallet.GetAssetType()))
+	}
+	return offlineW, nil
+}
+
+func (s *Server) exportUnsignedTx(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p sendParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	s.sendMtx.Lock()
+	defer s.sendMtx.Unlock()
+
+	offlineW, err := s.offlineSigningWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.prepareSend(&p, true); err != nil {
+		return nil, err
+	}
+
+	unsignedTx, err := offlineW.ExportUnsignedTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(unsignedTx), nil
+}
+
+func (s *Server) signExternalTx(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p signExternalTxParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+	if len(p.UnsignedTx) == 0 || p.Passphrase == "" {
+		return nil, newError(ErrCodeInvalidParams, "unsignedtx and passphrase are required")
+	}
+
+	offlineW, err := s.offlineSigningWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	return offlineW.SignExternalTransaction(p.UnsignedTx, p.Passphrase)
+}
+
+func (s *Server) broadcastSignedTx(_ context.Context, params json.RawMessage) (interface{}, error) {
+	var p broadcastSignedTxParams
+	if err := parseParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.SignedTx == "" {
+		return nil, newError(ErrCodeInvalidParams, "signedtx is required")
+	}
+
+	offlineW, err := s.offlineSigningWalletWithID(p.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := offlineW.BroadcastSignedTransaction(p.SignedTx, p.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Broadcast signed tx %s from wallet %d", txHash, p.WalletID)
+	return txHash, nil
+}
+
+func amountResult(amount sharedW.AssetAmount) AmountResult {
+	if amount == nil {
+		return AmountResult{}
+	}
+	return AmountResult{Atoms: amount.ToInt(), Coins: amount.ToCoin()}
+}
+
+func balanceResult(balance *sharedW.Balance) BalanceResult {
+	if balance == nil {
+		return BalanceResult{}
+	}
+
+	res := BalanceResult{
+		Total:          amountResult(balance.Total),
+		Spendable:      amountResult(balance.Spendable),
+		ImmatureReward: amountResult(balance.ImmatureReward),
+		Locked:         amountResult(balance.Locked),
+	}
+	if balance.UnConfirmed != nil {
+		unconfirmed := amountResult(balance.UnConfirmed)
+		res.Unconfirmed = &unconfirmed
+	}
+	if balance.LockedByTickets != nil {
+		lockedByTickets := amountResult(balance.LockedByTickets)
+		res.LockedByTickets = &lockedByTickets
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeHTTP(t *testing.T) {
+	s, err := New(Config{Listen: "127.0.0.1:0", Username: "user", Password: "pass"}, nil)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		user, pass string
+		body       string
+		wantStatus int
+		wantCode   int
+	}{{
+		name:       "bad credentials",
+		user:       "user",
+		pass:       "wrong",
+		body:       `{"jsonrpc":"2.0","id":1,"method":"listmethods"}`,
+		wantStatus: http.StatusUnauthorized,
+	}, {
+		name:       "malformed json",
+		user:       "user",
+		pass:       "pass",
+		body:       `{"jsonrpc":`,
+		wantStatus: http.StatusOK,
+		wantCode:   ErrCodeParse,
+	}, {
+		name:       "wrong version",
+		user:       "user",
+		pass:       "pass",
+		body:       `{"jsonrpc":"1.0","id":1,"method":"listmethods"}`,
+		wantStatus: http.StatusOK,
+		wantCode:   ErrCodeInvalidRequest,
+	}, {
+		name:       "unknown method",
+		user:       "user",
+		pass:       "pass",
+		body:       `{"jsonrpc":"2.0","id":1,"method":"nosuchmethod"}`,
+		wantStatus: http.StatusOK,
+		wantCode:   ErrCodeMethodNotFound,
+	}, {
+		name:       "list methods",
+		user:       "user",
+		pass:       "pass",
+		body:       `{"jsonrpc":"2.0","id":1,"method":"listmethods"}`,
+		wantStatus: http.StatusOK,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+			req.SetBasicAuth(test.user, test.pass)
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != test.wantStatus {
+				t.Fatalf("expected status %d, got %d", test.wantStatus, rec.Code)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Result json.RawMessage `json:"result"`
+				Error  *Error          `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("invalid response: %v", err)
+			}
+			switch {
+			case test.wantCode == 0 && resp.Error != nil:
+				t.Fatalf("unexpected error: %v", resp.Error)
+			case test.wantCode != 0 && (resp.Error == nil || resp.Error.Code != test.wantCode):
+				t.Fatalf("expected error code %d, got %+v", test.wantCode, resp.Error)
+			}
+		})
+	}
+}
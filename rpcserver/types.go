@@ -0,0 +1,189 @@
+package rpcserver
+
+import (
+	"encoding/json"
+)
+
+const jsonrpcVersion = "2.0"
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	ErrCodeParse          = -32700
+	ErrCodeInvalidRequest = -32600
+	ErrCodeMethodNotFound = -32601
+	ErrCodeInvalidParams  = -32602
+	ErrCodeInternal       = -32603
+
+	// ErrCodeWallet is returned when the wallet backend rejects a request.
+	ErrCodeWallet = -32000
+)
+
+// Request is a JSON-RPC 2.0 request. Params are always passed by name.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// Response is a JSON-RPC 2.0 response. Exactly one of Result and Error is set.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC 2.0 error object.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(code int, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+/** begin method params */
+
+type walletParams struct {
+	WalletID int `json:"walletid"`
+}
+
+type openWalletsParams struct {
+	StartupPassphrase string `json:"startuppassphrase"`
+}
+
+type startSyncParams struct {
+	WalletID   int    `json:"walletid"`
+	Passphrase string `json:"passphrase"`
+}
+
+type getBalanceParams struct {
+	WalletID int    `json:"walletid"`
+	Account  *int32 `json:"account"`
+}
+
+type getAddressParams struct {
+	WalletID int   `json:"walletid"`
+	Account  int32 `json:"account"`
+	New      bool  `json:"new"`
+}
+
+type listTransactionsParams struct {
+	WalletID    int    `json:"walletid"`
+	Offset      int32  `json:"offset"`
+	Limit       int32  `json:"limit"`
+	Filter      int32  `json:"filter"`
+	NewestFirst *bool  `json:"newestfirst"`
+	Search      string `json:"search"`
+}
+
+type getTransactionParams struct {
+	WalletID int    `json:"walletid"`
+	Hash     string `json:"hash"`
+}
+
+// Destination is a single recipient of a send request. Amount is in the
+// asset's smallest unit (atoms, satoshis or litoshis) and is ignored when
+// SendMax is true.
+type Destination struct {
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+	SendMax bool   `json:"sendmax"`
+}
+
+type sendParams struct {
+	WalletID     int           `json:"walletid"`
+	Account      int32         `json:"account"`
+	Destinations []Destination `json:"destinations"`
+	Passphrase   string        `json:"passphrase"`
+	Label        string        `json:"label"`
+}
+
+type broadcastPSBTParams struct {
+	WalletID   int    `json:"walletid"`
+	PSBT       string `json:"psbt"`
+	Passphrase string `json:"passphrase"`
+	Label      string `json:"label"`
+}
+
+type signExternalTxParams struct {
+	WalletID   int             `json:"walletid"`
+	UnsignedTx json.RawMessage `json:"unsignedtx"`
+	Passphrase string          `json:"passphrase"`
+}
+
+type broadcastSignedTxParams struct {
+	WalletID int    `json:"walletid"`
+	SignedTx string `json:"signedtx"`
+	Label    string `json:"label"`
+}
+
+/** end method params */
+
+/** begin method results */
+
+// WalletResult describes a wallet loaded by the assets manager.
+type WalletResult struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Asset         string `json:"asset"`
+	Opened        bool   `json:"opened"`
+	WatchOnly     bool   `json:"watchonly"`
+	Synced        bool   `json:"synced"`
+	Syncing       bool   `json:"syncing"`
+	BackedUp      bool   `json:"backedup"`
+	BestBlock     int32  `json:"bestblock"`
+	Confirmations int32  `json:"requiredconfirmations"`
+}
+
+// AmountResult reports an amount both in the asset's smallest unit and in
+// whole coins.
+type AmountResult struct {
+	Atoms int64   `json:"atoms"`
+	Coins float64 `json:"coins"`
+}
+
+// BalanceResult is the balance of a wallet or account.
+type BalanceResult struct {
+	Total           AmountResult  `json:"total"`
+	Spendable       AmountResult  `json:"spendable"`
+	ImmatureReward  AmountResult  `json:"immaturereward"`
+	Locked          AmountResult  `json:"locked"`
+	Unconfirmed     *AmountResult `json:"unconfirmed,omitempty"`
+	LockedByTickets *AmountResult `json:"lockedbytickets,omitempty"`
+}
+
+// AccountResult describes a single wallet account.
+type AccountResult struct {
+	Number  int32         `json:"number"`
+	Name    string        `json:"name"`
+	Balance BalanceResult `json:"balance"`
+}
+
+// FeeResult is the fee estimate for a pending send.
+type FeeResult struct {
+	Fee           AmountResult  `json:"fee"`
+	Change        *AmountResult `json:"change,omitempty"`
+	FeeRate       int64         `json:"feerate"`
+	EstimatedSize int           `json:"estimatedsize"`
+}
+
+// SyncStatusResult describes the sync state of a wallet.
+type SyncStatusResult struct {
+	WalletID        int    `json:"walletid"`
+	Asset           string `json:"asset"`
+	Synced          bool   `json:"synced"`
+	Syncing         bool   `json:"syncing"`
+	Rescanning      bool   `json:"rescanning"`
+	ConnectedPeers  int32  `json:"connectedpeers"`
+	BestBlockHeight int32  `json:"bestblockheight"`
+	BestBlockTime   int64  `json:"bestblocktime"`
+}
+
+/** end method results */
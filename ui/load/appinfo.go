@@ -13,6 +13,7 @@ import (
 	"gioui.org/widget/material"
 	"github.com/crypto-power/cryptopower/app"
 	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/assets"
 	"github.com/crypto-power/cryptopower/ui/values"
@@ -39,6 +40,9 @@ type AppInfo struct {
 
 	currentAppWidth unit.Dp
 	isMobileView    bool
+
+	paymentURIMtx sync.Mutex
+	paymentURI    *uri.PaymentURI
 }
 
 // StartApp returns an instance of AppInfo with the startUpTime set to the
@@ -125,6 +129,24 @@ func (app *AppInfo) StartPage() app.Page {
 	return app.startPage
 }
 
+// SetPaymentURI stores a payment request the app was opened with, to be paid
+// once the wallets are unlocked.
+func (app *AppInfo) SetPaymentURI(paymentURI *uri.PaymentURI) {
+	app.paymentURIMtx.Lock()
+	defer app.paymentURIMtx.Unlock()
+	app.paymentURI = paymentURI
+}
+
+// TakePaymentURI returns the payment request set with SetPaymentURI, if any,
+// and clears it so it's only handled once.
+func (app *AppInfo) TakePaymentURI() *uri.PaymentURI {
+	app.paymentURIMtx.Lock()
+	defer app.paymentURIMtx.Unlock()
+	paymentURI := app.paymentURI
+	app.paymentURI = nil
+	return paymentURI
+}
+
 // CanChangeNetworkType is true if it is possible to change the network type
 // used by the app.
 func (app *AppInfo) CanChangeNetworkType() bool {
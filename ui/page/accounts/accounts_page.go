@@ -12,7 +12,6 @@ import (
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
-	"github.com/crypto-power/cryptopower/ui/modal"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
@@ -261,27 +260,7 @@ func (pg *Page) accountBalanceLayout(title string, bal sharedW.AssetAmount, balA
 // Part of the load.Page interface.
 func (pg *Page) HandleUserInteractions(gtx C) {
 	if pg.addAccountBtn.Clicked(gtx) {
-		createAccountModal := modal.NewCreatePasswordModal(pg.Load).
-			Title(values.String(values.StrCreateNewAccount)).
-			EnableName(true).
-			NameHint(values.String(values.StrAcctName)).
-			EnableConfirmPassword(false).
-			PasswordHint(values.String(values.StrSpendingPassword)).
-			SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
-				_, err := pg.wallet.CreateNewAccount(accountName, password)
-				if err != nil {
-					m.SetError(err.Error())
-					return false
-				}
-				pg.loadWalletAccount()
-				m.Dismiss()
-
-				info := modal.NewSuccessModal(pg.Load, values.StringF(values.StrAcctCreated),
-					modal.DefaultClickFunc())
-				pg.ParentWindow().ShowModal(info)
-				return true
-			})
-		pg.ParentWindow().ShowModal(createAccountModal)
+		components.ShowCreateAccountModal(pg.Load, pg.ParentWindow(), pg.wallet, pg.loadWalletAccount)
 	}
 
 	if clicked, selectedItem := pg.accountsList.ItemClicked(); clicked {
@@ -295,3 +274,20 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		}
 	}
 }
+
+// frozenBalance returns the total value of the frozen outputs of account.
+func frozenBalance(wallet sharedW.Asset, account int32) string {
+	utxos, err := wallet.UnspentOutputs(account)
+	if err != nil {
+		log.Errorf("error fetching unspent outputs of account %d: %v", account, err)
+		return wallet.ToAmount(0).String()
+	}
+
+	var total int64
+	for _, utxo := range utxos {
+		if utxo.Frozen {
+			total += utxo.Amount.ToInt()
+		}
+	}
+	return wallet.ToAmount(total).String()
+}
@@ -42,6 +42,7 @@ type BTCAcctDetailsPage struct {
 	totalBalance            string
 	spendableBalance        string
 	lockedBalance           string
+	frozenBalance           string
 	hdPath                  string
 	keys                    string
 	extendedKey             string
@@ -83,8 +84,21 @@ func (pg *BTCAcctDetailsPage) OnNavigatedTo() {
 	pg.totalBalance = pg.account.Balance.Total.String()
 	pg.spendableBalance = pg.account.Balance.Spendable.String()
 	pg.lockedBalance = pg.account.Balance.Locked.String()
+	pg.frozenBalance = frozenBalance(pg.wallet, pg.account.Number)
 
 	pg.hdPath = pg.AssetsManager.BTCHDPrefix() + strconv.Itoa(int(pg.account.AccountNumber)) + "'"
+	// Accounts outside the default key scope derive from a different
+	// purpose, which only the wallet knows about.
+	if wallet, ok := pg.wallet.(interface {
+		HDPathForAccount(int32) (string, error)
+	}); ok {
+		hdPath, err := wallet.HDPathForAccount(pg.account.Number)
+		if err != nil {
+			log.Errorf("error fetching HD path of account %d: %v", pg.account.Number, err)
+		} else {
+			pg.hdPath = hdPath + "'"
+		}
+	}
 
 	ext := pg.account.ExternalKeyCount
 	internal := pg.account.InternalKeyCount
@@ -281,6 +295,9 @@ func (pg *BTCAcctDetailsPage) accountBalanceLayout(gtx C) D {
 			layout.Rigid(func(gtx C) D {
 				return pg.acctBalLayout(gtx, values.String(values.StrLocked), pg.lockedBalance, false)
 			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.acctBalLayout(gtx, values.String(values.StrFrozen), pg.frozenBalance, false)
+			}),
 		)
 	})
 }
@@ -46,6 +46,7 @@ type AcctDetailsPage struct {
 	totalBalance     string
 	spendableBalance string
 	immatureBalance  string
+	frozenBalance    string
 	lockeByTicket    string
 	votingAuthority  string
 	hdPath           string
@@ -86,6 +87,7 @@ func NewDCRAcctDetailsPage(l *load.Load, wallet sharedW.Asset, account *sharedW.
 func (pg *AcctDetailsPage) OnNavigatedTo() {
 	bal := pg.account.Balance
 	pg.lockedBalance = bal.Locked.String()
+	pg.frozenBalance = frozenBalance(pg.wallet, pg.account.Number)
 	pg.totalBalance = bal.Total.String()
 	pg.spendableBalance = bal.Spendable.String()
 	pg.immatureBalance = pg.wallet.ToAmount(bal.ImmatureReward.ToInt() + bal.ImmatureStakeGeneration.ToInt()).String()
@@ -238,6 +240,9 @@ func (pg *AcctDetailsPage) accountBalanceLayout(gtx C) D {
 					layout.Rigid(func(gtx C) D {
 						return pg.acctBalLayout(gtx, values.String(values.StrImmature), pg.immatureBalance, false)
 					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.acctBalLayout(gtx, values.String(values.StrFrozen), pg.frozenBalance, false)
+					}),
 					layout.Rigid(func(gtx C) D {
 						return pg.acctBalLayout(gtx, values.String(values.StrLockedByTickets), pg.lockeByTicket, false)
 					}),
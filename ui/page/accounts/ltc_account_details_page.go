@@ -42,6 +42,7 @@ type LTCAcctDetailsPage struct {
 	totalBalance            string
 	spendableBalance        string
 	lockedBalance           string
+	frozenBalance           string
 	hdPath                  string
 	keys                    string
 	extendedKey             string
@@ -83,6 +84,7 @@ func (pg *LTCAcctDetailsPage) OnNavigatedTo() {
 	pg.totalBalance = pg.account.Balance.Total.String()
 	pg.spendableBalance = pg.account.Balance.Spendable.String()
 	pg.lockedBalance = pg.account.Balance.Locked.String()
+	pg.frozenBalance = frozenBalance(pg.wallet, pg.account.Number)
 
 	pg.hdPath = pg.AssetsManager.LTCHDPrefix() + strconv.Itoa(int(pg.account.AccountNumber)) + "'"
 
@@ -278,6 +280,9 @@ func (pg *LTCAcctDetailsPage) accountBalanceLayout(gtx C) D {
 			layout.Rigid(func(gtx C) D {
 				return pg.acctBalLayout(gtx, values.String(values.StrLocked), pg.lockedBalance, false)
 			}),
+			layout.Rigid(func(gtx C) D {
+				return pg.acctBalLayout(gtx, values.String(values.StrFrozen), pg.frozenBalance, false)
+			}),
 		)
 	})
 }
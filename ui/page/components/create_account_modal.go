@@ -0,0 +1,87 @@
+package components
+
+import (
+	"strconv"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/preference"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// scopedAccountCreator is implemented by wallets that can create accounts
+// deriving different address types.
+type scopedAccountCreator interface {
+	CreateNewAccountWithScope(accountName, privPass string, scope sharedW.KeyScope) (int32, error)
+}
+
+// addressTypes maps the purpose of the BTC key scopes to the name of the
+// address type they derive.
+var addressTypes = map[uint32]string{
+	84: values.StrNativeSegwit,
+	49: values.StrNestedSegwit,
+	86: values.StrTaproot,
+}
+
+// ShowCreateAccountModal asks for the name of a new account of wallet and the
+// spending password, then creates the account. Wallets that support several
+// address types are first asked which one the account should use. onCreated
+// is called once the account is created.
+func ShowCreateAccountModal(l *load.Load, window app.WindowNavigator, wallet sharedW.Asset, onCreated func()) {
+	creator, ok := wallet.(scopedAccountCreator)
+	if !ok {
+		showCreateAccountModal(l, window, wallet.CreateNewAccount, onCreated)
+		return
+	}
+
+	scopes := btc.SupportedKeyScopes()
+	items := make([]preference.ItemPreference, 0, len(scopes))
+	for _, scope := range scopes {
+		items = append(items, preference.ItemPreference{
+			Key:   strconv.FormatUint(uint64(scope.Purpose), 10),
+			Value: addressTypes[scope.Purpose],
+		})
+	}
+
+	addressTypeModal := preference.NewListPreference(l, "", items[0].Key, items).
+		Title(values.StrAddressType).
+		UpdateValues(func(key string) {
+			for _, scope := range scopes {
+				if strconv.FormatUint(uint64(scope.Purpose), 10) != key {
+					continue
+				}
+				create := func(accountName, password string) (int32, error) {
+					return creator.CreateNewAccountWithScope(accountName, password, scope)
+				}
+				showCreateAccountModal(l, window, create, onCreated)
+			}
+		})
+	window.ShowModal(addressTypeModal)
+}
+
+func showCreateAccountModal(l *load.Load, window app.WindowNavigator, create func(accountName, password string) (int32, error), onCreated func()) {
+	createAccountModal := modal.NewCreatePasswordModal(l).
+		Title(values.String(values.StrCreateNewAccount)).
+		EnableName(true).
+		NameHint(values.String(values.StrAcctName)).
+		EnableConfirmPassword(false).
+		PasswordHint(values.String(values.StrSpendingPassword)).
+		SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
+			_, err := create(accountName, password)
+			if err != nil {
+				m.SetError(err.Error())
+				return false
+			}
+			onCreated()
+			m.Dismiss()
+
+			info := modal.NewSuccessModal(l, values.StringF(values.StrAcctCreated),
+				modal.DefaultClickFunc())
+			window.ShowModal(info)
+			return true
+		})
+	window.ShowModal(createAccountModal)
+}
@@ -53,6 +53,7 @@ type ConsensusPage struct {
 	syncButton          *cryptomaterial.Clickable
 	materialLoader      material.LoaderStyle
 	viewVotingDashboard *cryptomaterial.Clickable
+	viewTicketVotes     *cryptomaterial.Clickable
 	copyRedirectURL     *cryptomaterial.Clickable
 	redirectIcon        *cryptomaterial.Image
 
@@ -83,6 +84,7 @@ func NewConsensusPage(l *load.Load) *ConsensusPage {
 		},
 		redirectIcon:        l.Theme.Icons.RedirectIcon,
 		viewVotingDashboard: l.Theme.NewClickable(true),
+		viewTicketVotes:     l.Theme.NewClickable(true),
 		copyRedirectURL:     l.Theme.NewClickable(false),
 	}
 
@@ -189,6 +191,9 @@ func (pg *ConsensusPage) initWalletSelector() {
 		items = append(items, item)
 	}
 	pg.walletDropDown = pg.Theme.DropdownWithCustomPos(items, values.WalletsDropdownGroup, 1, 0, false)
+	if len(pg.assetWallets) > 0 {
+		pg.selectedDCRWallet = pg.assetWallets[0].(*dcr.Asset)
+	}
 	pg.walletDropDown.Width = values.MarginPadding150
 	settingCommonDropdown(pg.Theme, pg.walletDropDown)
 	pg.walletDropDown.SetConvertTextSize(pg.ConvertTextSize)
@@ -337,6 +342,10 @@ func (pg *ConsensusPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(info)
 	}
 
+	if pg.viewTicketVotes.Clicked(gtx) && pg.selectedDCRWallet != nil {
+		pg.showTicketVotesModal()
+	}
+
 	if pg.filterBtn.Clicked(gtx) {
 		pg.isFilterOpen = !pg.isFilterOpen
 	}
@@ -516,6 +525,16 @@ func (pg *ConsensusPage) layoutRedirectVoting(gtx C) D {
 				)
 			})
 		}),
+		layout.Rigid(func(gtx C) D {
+			if pg.selectedDCRWallet == nil {
+				return D{}
+			}
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize16), values.String(values.StrTicketVotes))
+				lbl.Color = pg.Theme.Color.Primary
+				return pg.viewTicketVotes.Layout(gtx, lbl.Layout)
+			})
+		}),
 		layout.Rigid(func(gtx C) D {
 			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.End}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
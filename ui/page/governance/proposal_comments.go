@@ -0,0 +1,110 @@
+package governance
+
+import (
+	"fmt"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/unit"
+
+	"github.com/crypto-power/cryptopower/ui/renderers"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// maxCommentIndent is the deepest reply level that is indented further.
+const maxCommentIndent = 5
+
+// loadProposalComments loads the proposal's comments in the background,
+// fetching them first if they were never synced.
+func (pg *ProposalDetails) loadProposalComments() {
+	if pg.loadingComments {
+		return
+	}
+	pg.loadingComments = true
+
+	go func() {
+		defer func() {
+			pg.loadingComments = false
+			pg.ParentWindow().Reload()
+		}()
+
+		politeia := pg.AssetsManager.Politeia
+		token := pg.proposal.Token
+		if pg.proposal.NumComments > 0 && !politeia.IsProposalCommentsSynced(token) {
+			if err := politeia.FetchProposalComments(token); err != nil {
+				log.Errorf("Error fetching proposal comments: %v", err)
+				return
+			}
+		}
+
+		comments, err := politeia.GetProposalComments(token)
+		if err != nil {
+			log.Errorf("Error loading proposal comments: %v", err)
+			return
+		}
+
+		commentWidgets := make([]layout.Widget, 0, len(comments))
+		for _, comment := range comments {
+			indent := comment.Depth
+			if indent > maxCommentIndent {
+				indent = maxCommentIndent
+			}
+			inset := layout.Inset{Left: unit.Dp(float32(16 * indent))}
+
+			header := fmt.Sprintf("%s · %s · %s", comment.Username, pageutils.TimeAgo(comment.CreatedAt),
+				values.StringF(values.StrCommentVotes, comment.Upvotes, comment.Downvotes))
+			commentWidgets = append(commentWidgets, func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding16, Left: inset.Left}.Layout(gtx, func(gtx C) D {
+					lbl := pg.Theme.Body2(header)
+					lbl.Color = pg.Theme.Color.GrayText2
+					lbl.Font.Weight = font.SemiBold
+					return lbl.Layout(gtx)
+				})
+			})
+
+			if comment.Deleted {
+				commentWidgets = append(commentWidgets, func(gtx C) D {
+					return inset.Layout(gtx, func(gtx C) D {
+						lbl := pg.Theme.Body2(values.String(values.StrCommentDeleted))
+						lbl.Color = pg.Theme.Color.GrayText3
+						return lbl.Layout(gtx)
+					})
+				})
+				continue
+			}
+
+			bodyWidgets, _ := renderers.RenderMarkdown(pg.Load, pg.Theme, comment.Comment).Layout()
+			for _, w := range bodyWidgets {
+				w := w
+				commentWidgets = append(commentWidgets, func(gtx C) D {
+					return inset.Layout(gtx, w)
+				})
+			}
+		}
+
+		pg.commentCount = len(comments)
+		pg.commentWidgets = commentWidgets
+	}()
+}
+
+// commentsWidgets returns the widgets of the comments section, shown below
+// the proposal description.
+func (pg *ProposalDetails) commentsWidgets() []layout.Widget {
+	w := []layout.Widget{
+		pg.lineSeparator(layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding16}),
+		func(gtx C) D {
+			lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize18), values.StringF(values.StrCommentsCount, pg.commentCount))
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		},
+	}
+	if pg.commentCount == 0 && !pg.loadingComments {
+		w = append(w, func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrNoComments))
+			lbl.Color = pg.Theme.Color.GrayText3
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		})
+	}
+	return append(w, pg.commentWidgets...)
+}
@@ -58,6 +58,8 @@ type ProposalDetails struct {
 	redirectIcon *cryptomaterial.Image
 
 	viewInPoliteiaBtn *cryptomaterial.Clickable
+	compareVersions   *cryptomaterial.Clickable
+	voteReceipts      *cryptomaterial.Clickable
 	copyRedirectURL   *cryptomaterial.Clickable
 
 	descriptionCard cryptomaterial.Card
@@ -71,6 +73,12 @@ type ProposalDetails struct {
 
 	voteBar            *components.VoteBar
 	loadingDescription bool
+
+	commentWidgets  []layout.Widget
+	commentCount    int
+	loadingComments bool
+
+	hasVoteReceipts bool
 }
 
 func NewProposalDetailsPage(l *load.Load, proposal *libwallet.Proposal) *ProposalDetails {
@@ -89,6 +97,8 @@ func NewProposalDetailsPage(l *load.Load, proposal *libwallet.Proposal) *Proposa
 		rejectedIcon:      l.Theme.Icons.NavigationCancel,
 		successIcon:       l.Theme.Icons.ActionCheckCircle,
 		viewInPoliteiaBtn: l.Theme.NewClickable(true),
+		compareVersions:   l.Theme.NewClickable(true),
+		voteReceipts:      l.Theme.NewClickable(true),
 		copyRedirectURL:   l.Theme.NewClickable(false),
 		voteBar:           components.NewVoteBar(l),
 	}
@@ -116,6 +126,8 @@ func NewProposalDetailsPage(l *load.Load, proposal *libwallet.Proposal) *Proposa
 func (pg *ProposalDetails) OnNavigatedTo() {
 	pg.initWalletSelector()
 	pg.loadProposalDescription()
+	pg.loadProposalComments()
+	pg.loadVoteReceipts()
 	pg.listenForSyncNotifications() // listener is stopped in OnNavigatedFrom()
 }
 
@@ -223,7 +235,15 @@ func (pg *ProposalDetails) HandleUserInteractions(gtx C) {
 			pg.displayCreateWalletModal(libutils.DCRWalletAsset)
 			return
 		}
-		pg.ParentWindow().ShowModal(newVoteModal(pg.Load, pg.proposal))
+		pg.ParentWindow().ShowModal(newVoteModal(pg.Load, pg.proposal).VotesCast(pg.loadVoteReceipts))
+	}
+
+	if pg.compareVersions.Clicked(gtx) {
+		pg.showVersionDiffModal()
+	}
+
+	if pg.voteReceipts.Clicked(gtx) {
+		pg.showVoteReceiptsModal()
 	}
 
 	if pg.viewInPoliteiaBtn.Clicked(gtx) {
@@ -287,6 +307,7 @@ func (pg *ProposalDetails) listenForSyncNotifications() {
 			proposal, err := pg.AssetsManager.Politeia.GetProposalRaw(pg.proposal.Token)
 			if err == nil {
 				pg.proposal = &libwallet.Proposal{Proposal: *proposal}
+				pg.loadProposalComments()
 				pg.ParentWindow().Reload()
 			}
 		}
@@ -648,12 +669,15 @@ func (pg *ProposalDetails) layoutDescription(gtx C) D {
 			)
 		},
 		pg.layoutRedirect(values.String(values.StrViewOnPoliteia), pg.redirectIcon, pg.viewInPoliteiaBtn),
+		pg.layoutCompareVersions,
+		pg.layoutVoteReceiptsLink,
 		pg.lineSeparator(layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding16}),
 	}
 
 	itemWidgets := pg.getProposalItemWidgets()
 	if itemWidgets != nil {
 		w = append(w, itemWidgets.widgets...)
+		w = append(w, pg.commentsWidgets()...)
 	} else {
 		loading := func(gtx C) D {
 			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, layout.Flexed(1, func(gtx C) D {
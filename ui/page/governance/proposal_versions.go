@@ -0,0 +1,147 @@
+package governance
+
+import (
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// diffContextLines is the number of unchanged lines shown around changes.
+const diffContextLines = 2
+
+// showVersionDiffModal shows the changes between two versions of the
+// proposal, the latest two by default.
+func (pg *ProposalDetails) showVersionDiffModal() {
+	token := pg.proposal.Token
+	fromGroup, toGroup := new(widget.Enum), new(widget.Enum)
+	var versions []string
+	var diffFrom, diffTo string
+	var diffWidgets []layout.Widget
+
+	go func() {
+		fetched, err := pg.AssetsManager.Politeia.FetchProposalVersions(token)
+		if err != nil {
+			log.Errorf("Error fetching proposal versions: %v", err)
+			diffWidgets = []layout.Widget{pg.Theme.Body2(err.Error()).Layout}
+			versions = []string{}
+			pg.ParentWindow().Reload()
+			return
+		}
+		loaded := make([]string, len(fetched))
+		for i, version := range fetched {
+			loaded[i] = version.Version
+		}
+		if len(loaded) > 1 {
+			fromGroup.Value, toGroup.Value = loaded[len(loaded)-2], loaded[len(loaded)-1]
+		}
+		versions = loaded
+		pg.ParentWindow().Reload()
+	}()
+
+	updateDiff := func() {
+		diffFrom, diffTo = fromGroup.Value, toGroup.Value
+		lines, err := pg.AssetsManager.Politeia.ProposalVersionDiff(token, diffFrom, diffTo)
+		if err != nil {
+			diffWidgets = []layout.Widget{pg.Theme.Body2(err.Error()).Layout}
+			return
+		}
+
+		keep := make([]bool, len(lines))
+		for i, line := range lines {
+			if line.Op == libwallet.ProposalDiffUnchanged {
+				continue
+			}
+			for j := i - diffContextLines; j <= i+diffContextLines; j++ {
+				if j >= 0 && j < len(lines) {
+					keep[j] = true
+				}
+			}
+		}
+
+		diffWidgets = nil
+		for i, line := range lines {
+			if !keep[i] {
+				if i > 0 && keep[i-1] {
+					diffWidgets = append(diffWidgets, pg.diffLineWidget("…", pg.Theme.Color.GrayText3))
+				}
+				continue
+			}
+			switch line.Op {
+			case libwallet.ProposalDiffAdded:
+				diffWidgets = append(diffWidgets, pg.diffLineWidget("+ "+line.Text, pg.Theme.Color.Success))
+			case libwallet.ProposalDiffRemoved:
+				diffWidgets = append(diffWidgets, pg.diffLineWidget("- "+line.Text, pg.Theme.Color.Danger))
+			default:
+				diffWidgets = append(diffWidgets, pg.diffLineWidget("  "+line.Text, pg.Theme.Color.GrayText2))
+			}
+		}
+		if len(diffWidgets) == 0 {
+			diffWidgets = []layout.Widget{pg.Theme.Body2(values.String(values.StrNoChanges)).Layout}
+		}
+	}
+
+	diffModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrCompareVersions)).
+		UseCustomWidget(func(gtx C) D {
+			if versions == nil {
+				return layout.Center.Layout(gtx, material.Loader(pg.Theme.Base).Layout)
+			}
+			if fromGroup.Value != diffFrom || toGroup.Value != diffTo {
+				updateDiff()
+			}
+
+			children := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					return pg.versionChoices(gtx, values.String(values.StrFromVersion), fromGroup, versions)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.versionChoices(gtx, values.String(values.StrToVersion), toGroup, versions)
+				}),
+				layout.Rigid(pg.lineSeparator(layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8})),
+			}
+			for _, w := range diffWidgets {
+				children = append(children, layout.Rigid(w))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	pg.ParentWindow().ShowModal(diffModal)
+}
+
+func (pg *ProposalDetails) versionChoices(gtx C, label string, group *widget.Enum, versions []string) D {
+	items := make([]layout.FlexChild, 0, len(versions))
+	for _, version := range versions {
+		radioBtn := pg.Theme.RadioButton(group, version, version, pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary)
+		items = append(items, layout.Rigid(radioBtn.Layout))
+	}
+	return components.EndToEndRow(gtx, pg.Theme.Body1(label).Layout, func(gtx C) D {
+		return layout.Flex{}.Layout(gtx, items...)
+	})
+}
+
+func (pg *ProposalDetails) diffLineWidget(text string, clr color.NRGBA) layout.Widget {
+	return func(gtx C) D {
+		lbl := pg.Theme.Body2(text)
+		lbl.Color = clr
+		return lbl.Layout(gtx)
+	}
+}
+
+func (pg *ProposalDetails) layoutCompareVersions(gtx C) D {
+	if pg.proposal.Version == "" || pg.proposal.Version == "1" {
+		return D{}
+	}
+	return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize16), values.String(values.StrCompareVersions))
+		lbl.Color = pg.Theme.Color.Primary
+		return pg.compareVersions.Layout(gtx, lbl.Layout)
+	})
+}
@@ -29,6 +29,8 @@ type voteModal struct {
 	detailsCancel  context.CancelFunc
 	voteDetails    *libwallet.ProposalVoteDetails
 	voteDetailsErr error
+	// walletsVoteDetails is set when voting with all wallets.
+	walletsVoteDetails []*libwallet.WalletVoteDetails
 
 	proposal *libwallet.Proposal
 	isVoting bool
@@ -40,6 +42,10 @@ type voteModal struct {
 	voteBtn             cryptomaterial.Button
 	cancelBtn           cryptomaterial.Button
 	navigateToStakePage *cryptomaterial.Clickable
+	allWallets          cryptomaterial.CheckBoxStyle
+	votingWallets       int
+
+	votesCast func()
 }
 
 func newVoteModal(l *load.Load, proposal *libwallet.Proposal) *voteModal {
@@ -50,6 +56,7 @@ func newVoteModal(l *load.Load, proposal *libwallet.Proposal) *voteModal {
 		materialLoader: material.Loader(l.Theme.Base),
 		voteBtn:        l.Theme.Button(values.String(values.StrVote)),
 		cancelBtn:      l.Theme.OutlineButton(values.String(values.StrCancel)),
+		allWallets:     l.Theme.CheckBox(new(widget.Bool), values.String(values.StrVoteWithAllWallets)),
 	}
 
 	vm.yesVote = newInputVoteOptions(vm.Load, values.String(values.StrYes))
@@ -60,41 +67,73 @@ func newVoteModal(l *load.Load, proposal *libwallet.Proposal) *voteModal {
 
 	vm.walletSelector = NewDCRWalletSelector(l).
 		Title(values.String(values.StrVotingWallet)).
-		WalletSelected(func(w sharedW.Asset) {
-			vm.detailsMu.Lock()
-			vm.yesVote.reset()
-			vm.noVote.reset()
-			// cancel current loading thread if any.
-			if vm.detailsCancel != nil {
-				vm.detailsCancel()
-			}
+		WalletSelected(vm.loadVoteDetails).
+		WalletValidator(func(w sharedW.Asset) bool {
+			return !w.IsWatchingOnlyWallet()
+		})
 
-			ctx, cancel := context.WithCancel(context.Background())
-			vm.detailsCancel = cancel
+	for _, w := range vm.AssetsManager.AllDCRWallets() {
+		if !w.IsWatchingOnlyWallet() {
+			vm.votingWallets++
+		}
+	}
 
-			vm.voteDetails = nil
-			vm.voteDetailsErr = nil
+	return vm
+}
 
-			vm.detailsMu.Unlock()
+// loadVoteDetails loads the vote details of the provided wallet, or of all
+// wallets if it's nil.
+func (vm *voteModal) loadVoteDetails(w sharedW.Asset) {
+	vm.detailsMu.Lock()
+	vm.yesVote.reset()
+	vm.noVote.reset()
+	// cancel current loading thread if any.
+	if vm.detailsCancel != nil {
+		vm.detailsCancel()
+	}
 
-			vm.ParentWindow().Reload()
+	ctx, cancel := context.WithCancel(context.Background())
+	vm.detailsCancel = cancel
 
-			go func() {
-				voteDetails, err := vm.AssetsManager.Politeia.ProposalVoteDetailsRaw(ctx, w.Internal().DCR, vm.proposal.Token)
-				vm.detailsMu.Lock()
-				if !components.ContextDone(ctx) {
-					if voteDetails != nil {
-						vm.voteDetails = &libwallet.ProposalVoteDetails{ProposalVoteDetails: *voteDetails}
-					}
-					vm.voteDetailsErr = err
-				}
-				vm.detailsMu.Unlock()
-			}()
-		}).
-		WalletValidator(func(w sharedW.Asset) bool {
-			return !w.IsWatchingOnlyWallet()
-		})
+	vm.voteDetails = nil
+	vm.voteDetailsErr = nil
+	vm.walletsVoteDetails = nil
 
+	vm.detailsMu.Unlock()
+
+	vm.ParentWindow().Reload()
+
+	go func() {
+		var voteDetails *libwallet.ProposalVoteDetails
+		var walletsVoteDetails []*libwallet.WalletVoteDetails
+		var err error
+		if w == nil {
+			walletsVoteDetails, err = vm.AssetsManager.AllWalletsProposalVoteDetails(ctx, vm.proposal.Token)
+			if err == nil {
+				voteDetails = libwallet.CombineVoteDetails(walletsVoteDetails)
+			}
+		} else {
+			details, detailsErr := vm.AssetsManager.Politeia.ProposalVoteDetailsRaw(ctx, w.Internal().DCR, vm.proposal.Token)
+			if details != nil {
+				voteDetails = &libwallet.ProposalVoteDetails{ProposalVoteDetails: *details}
+			}
+			err = detailsErr
+		}
+
+		vm.detailsMu.Lock()
+		if !components.ContextDone(ctx) {
+			vm.voteDetails = voteDetails
+			vm.walletsVoteDetails = walletsVoteDetails
+			vm.voteDetailsErr = err
+		}
+		vm.detailsMu.Unlock()
+	}()
+}
+
+// VotesCast sets a callback run after votes are sent, even if some of them
+// were rejected.
+func (vm *voteModal) VotesCast(callback func()) *voteModal {
+	vm.votesCast = callback
 	return vm
 }
 
@@ -132,8 +171,14 @@ func (vm *voteModal) remainingVotes() int {
 func (vm *voteModal) sendVotes() {
 	vm.detailsMu.Lock()
 	tickets := vm.voteDetails.EligibleTickets
+	walletsVoteDetails := vm.walletsVoteDetails
 	vm.detailsMu.Unlock()
 
+	if walletsVoteDetails != nil {
+		vm.sendAllWalletsVotes(walletsVoteDetails)
+		return
+	}
+
 	votes := make([]*libwallet.ProposalVote, 0)
 	addVotes := func(bit string, count int) {
 		for i := 0; i < count; i++ {
@@ -153,6 +198,9 @@ func (vm *voteModal) sendVotes() {
 		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
 			w := vm.walletSelector.selectedWallet.Internal().DCR
 			err := vm.AssetsManager.Politeia.CastVotes(ctx, w, libwallet.ConvertVotes(votes), vm.proposal.Token, password)
+			if vm.votesCast != nil {
+				vm.votesCast()
+			}
 			if err != nil {
 				pm.SetError(err.Error())
 				return false
@@ -168,11 +216,133 @@ func (vm *voteModal) sendVotes() {
 	vm.ParentWindow().ShowModal(passwordModal)
 }
 
+// sendAllWalletsVotes splits the votes across the tickets of all wallets,
+// asks for the passphrase of each locked wallet that votes and then casts the
+// votes of every wallet, reporting the result of each.
+func (vm *voteModal) sendAllWalletsVotes(walletsVoteDetails []*libwallet.WalletVoteDetails) {
+	split, err := libwallet.SplitProposalVotes(walletsVoteDetails, vm.yesVote.voteCount(), vm.noVote.voteCount())
+	if err != nil {
+		vm.isVoting = false
+		errModal := modal.NewErrorModal(vm.Load, err.Error(), modal.DefaultClickFunc())
+		vm.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	passphrases := make(map[int]string)
+	var unlockNext func(i int)
+	unlockNext = func(i int) {
+		for ; i < len(split); i++ {
+			if w := vm.AssetsManager.WalletWithID(split[i].WalletID); w != nil && w.IsLocked() {
+				break
+			}
+		}
+		if i == len(split) {
+			go vm.castAllWalletsVotes(split, passphrases)
+			return
+		}
+
+		walletVotes := split[i]
+		passwordModal := modal.NewCreatePasswordModal(vm.Load).
+			EnableName(false).
+			EnableConfirmPassword(false).
+			Title(values.StringF(values.StrUnlockWalletToVote, walletVotes.WalletName)).
+			SetNegativeButtonCallback(func() { vm.isVoting = false }).
+			SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+				w := vm.AssetsManager.WalletWithID(walletVotes.WalletID)
+				if err := w.UnlockWallet(password); err != nil {
+					pm.SetError(err.Error())
+					return false
+				}
+				w.LockWallet()
+
+				passphrases[walletVotes.WalletID] = password
+				pm.Dismiss()
+				unlockNext(i + 1)
+				return true
+			})
+		vm.ParentWindow().ShowModal(passwordModal)
+	}
+	unlockNext(0)
+}
+
+func (vm *voteModal) castAllWalletsVotes(split []*libwallet.WalletVotes, passphrases map[int]string) {
+	ctx := context.Background()
+	results := vm.AssetsManager.CastProposalVotes(ctx, vm.proposal.Token, split, passphrases)
+	if vm.votesCast != nil {
+		vm.votesCast()
+	}
+	go func() { _ = vm.AssetsManager.Politeia.Sync(ctx) }()
+
+	vm.isVoting = false
+	vm.Dismiss()
+	resultsModal := modal.NewCustomModal(vm.Load).
+		Title(values.String(values.StrVoteResults)).
+		UseCustomWidget(func(gtx C) D {
+			children := make([]layout.FlexChild, 0, len(results))
+			for _, result := range results {
+				result := result
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+						return vm.layoutWalletVoteResult(gtx, result)
+					})
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	vm.ParentWindow().ShowModal(resultsModal)
+}
+
+func (vm *voteModal) layoutWalletVoteResult(gtx C, result *libwallet.WalletVoteResult) D {
+	status := vm.Theme.Body2(values.String(values.StrVoteSent))
+	status.Color = vm.Theme.Color.Success
+	if result.Err != nil {
+		status.Text = values.TranslateErr(result.Err.Error())
+		status.Color = vm.Theme.Color.Danger
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			counts := values.StringF(values.StrWalletVoteCounts, result.YesVotes, result.NoVotes)
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				lbl := vm.Theme.Body1(result.WalletName)
+				lbl.Font.Weight = font.SemiBold
+				return lbl.Layout(gtx)
+			}, vm.Theme.Body2(counts).Layout)
+		}),
+		layout.Rigid(status.Layout),
+	)
+}
+
+// layoutWalletsVoteDetailsErrors lists the wallets left out of the votes
+// because their vote details couldn't be loaded.
+func (vm *voteModal) layoutWalletsVoteDetailsErrors(gtx C, walletsVoteDetails []*libwallet.WalletVoteDetails) D {
+	children := make([]layout.FlexChild, 0)
+	for _, details := range walletsVoteDetails {
+		if details.Err == nil {
+			continue
+		}
+		lbl := vm.Theme.Body2(values.StringF(values.StrWalletVoteDetailsErr, details.WalletName, values.TranslateErr(details.Err.Error())))
+		lbl.Color = vm.Theme.Color.Danger
+		children = append(children, layout.Rigid(lbl.Layout))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
 func (vm *voteModal) Handle(gtx C) {
 	if vm.cancelBtn.Clicked(gtx) && !vm.isVoting {
 		vm.Dismiss()
 	}
 
+	if vm.allWallets.CheckBox.Update(gtx) {
+		if vm.allWallets.CheckBox.Value {
+			vm.loadVoteDetails(nil)
+		} else {
+			vm.loadVoteDetails(vm.walletSelector.selectedWallet)
+		}
+	}
+
 	vm.handleVoteCountButtons(gtx, vm.yesVote)
 	vm.handleVoteCountButtons(gtx, vm.noVote)
 
@@ -202,6 +372,7 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 	vm.detailsMu.Lock()
 	voteDetails := vm.voteDetails
 	voteDetailsErr := vm.voteDetailsErr
+	walletsVoteDetails := vm.walletsVoteDetails
 	vm.detailsMu.Unlock()
 	w := []layout.Widget{
 		func(gtx C) D {
@@ -210,8 +381,20 @@ func (vm *voteModal) Layout(gtx layout.Context) D {
 			return t.Layout(gtx)
 		},
 		func(gtx C) D {
+			if vm.allWallets.CheckBox.Value {
+				return D{}
+			}
 			return vm.walletSelector.Layout(gtx, vm.ParentWindow())
 		},
+		func(gtx C) D {
+			if vm.votingWallets < 2 {
+				return D{}
+			}
+			return vm.allWallets.Layout(gtx)
+		},
+		func(gtx C) D {
+			return vm.layoutWalletsVoteDetailsErrors(gtx, walletsVoteDetails)
+		},
 		func(gtx C) D {
 			if voteDetails != nil {
 				return D{}
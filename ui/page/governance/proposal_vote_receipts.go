@@ -0,0 +1,207 @@
+package governance
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/io/clipboard"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// voteReceiptItem is a saved vote receipt with the result of verifying it.
+type voteReceiptItem struct {
+	ticket     string
+	voteOption string
+	timestamp  int64
+	verifyErr  error
+}
+
+// loadVoteReceipts checks in the background whether votes were cast on the
+// proposal from this device, to offer their receipts.
+func (pg *ProposalDetails) loadVoteReceipts() {
+	go func() {
+		receipts, err := pg.AssetsManager.Politeia.VoteReceipts(pg.proposal.Token)
+		if err != nil {
+			log.Errorf("Error loading vote receipts: %v", err)
+			return
+		}
+		pg.hasVoteReceipts = len(receipts) > 0
+		pg.ParentWindow().Reload()
+	}()
+}
+
+// showVoteReceiptsModal verifies the proposal's vote receipts against the
+// politeia server's key in the background and lists them, with a button to
+// copy them for verification elsewhere.
+func (pg *ProposalDetails) showVoteReceiptsModal() {
+	politeia := pg.AssetsManager.Politeia
+	token := pg.proposal.Token
+	go func() {
+		receipts, err := politeia.VoteReceipts(token)
+		if err != nil {
+			errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(errModal)
+			return
+		}
+
+		items := make([]*voteReceiptItem, len(receipts))
+		for i, receipt := range receipts {
+			items[i] = &voteReceiptItem{
+				ticket:     receipt.Ticket,
+				voteOption: receipt.VoteOption,
+				timestamp:  receipt.Timestamp,
+				verifyErr:  politeia.VerifyVoteReceipt(receipt),
+			}
+			if items[i].verifyErr != nil {
+				log.Errorf("Invalid receipt for the vote of ticket %s: %v", receipt.Ticket, items[i].verifyErr)
+			}
+		}
+		pg.showVoteReceiptItems(token, items)
+	}()
+}
+
+// showVoteReceiptItems lists the verified receipts of the proposal with the
+// provided token, or the receipts pasted in showVerifyVoteReceiptsModal if
+// token is empty.
+func (pg *ProposalDetails) showVoteReceiptItems(token string, items []*voteReceiptItem) {
+	politeia := pg.AssetsManager.Politeia
+	copyBtn := pg.Theme.OutlineButton(values.String(values.StrCopyReceipts))
+	copyBtn.TextSize = pg.ConvertTextSize(values.TextSize14)
+	verifyBtn := pg.Theme.OutlineButton(values.String(values.StrVerifyReceipts))
+	verifyBtn.TextSize = pg.ConvertTextSize(values.TextSize14)
+
+	msg := values.String(values.StrVoteReceiptsMsg)
+	if token == "" {
+		msg = values.String(values.StrImportedVoteReceiptsMsg)
+	}
+
+	receiptsModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrVoteReceipts)).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	receiptsModal.UseCustomWidget(func(gtx C) D {
+		if copyBtn.Clicked(gtx) {
+			exported, err := politeia.ExportVoteReceipts(token)
+			if err != nil {
+				log.Errorf("Error exporting vote receipts: %v", err)
+			} else {
+				gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(exported))})
+				pg.Toast.Notify(values.String(values.StrCopied))
+			}
+		}
+		if verifyBtn.Clicked(gtx) {
+			receiptsModal.Dismiss()
+			pg.showVerifyVoteReceiptsModal()
+		}
+
+		children := []layout.FlexChild{
+			layout.Rigid(func(gtx C) D {
+				lbl := pg.Theme.Body2(msg)
+				lbl.Color = pg.Theme.Color.GrayText2
+				return lbl.Layout(gtx)
+			}),
+		}
+		if len(items) == 0 {
+			children = append(children, layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.Theme.Body1(values.String(values.StrNoVoteReceipts)).Layout)
+			}))
+		}
+		for _, item := range items {
+			item := item
+			children = append(children, layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+					return pg.layoutVoteReceipt(gtx, item)
+				})
+			}))
+		}
+		if token != "" {
+			children = append(children, layout.Rigid(func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+					return layout.Flex{}.Layout(gtx,
+						layout.Rigid(func(gtx C) D {
+							if len(items) == 0 {
+								return D{}
+							}
+							return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, copyBtn.Layout)
+						}),
+						layout.Rigid(verifyBtn.Layout),
+					)
+				})
+			}))
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+	pg.ParentWindow().ShowModal(receiptsModal)
+}
+
+// showVerifyVoteReceiptsModal verifies receipts copied with "Copy receipts",
+// on this or another device, against the politeia server key saved by this
+// app, which works while the server is unreachable.
+func (pg *ProposalDetails) showVerifyVoteReceiptsModal() {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrPasteVoteReceipts)).
+		SetPositiveButtonCallback(func(data string, tm *modal.TextInputModal) bool {
+			receipts, errs, err := pg.AssetsManager.VerifyExportedVoteReceipts(data)
+			if err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+
+			items := make([]*voteReceiptItem, len(receipts))
+			for i, receipt := range receipts {
+				items[i] = &voteReceiptItem{
+					ticket:     receipt.Ticket,
+					voteOption: receipt.VoteOption,
+					timestamp:  receipt.Timestamp,
+					verifyErr:  errs[i],
+				}
+			}
+			pg.showVoteReceiptItems("", items)
+			return true
+		})
+	textModal.Title(values.String(values.StrVerifyReceipts)).
+		SetPositiveButtonText(values.String(values.StrVerifyReceipts))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+func (pg *ProposalDetails) layoutVoteReceipt(gtx C, item *voteReceiptItem) D {
+	status := pg.Theme.Body2(values.String(values.StrReceiptVerified))
+	status.Color = pg.Theme.Color.Success
+	if item.verifyErr != nil {
+		status.Text = values.String(values.StrReceiptInvalid)
+		status.Color = pg.Theme.Color.Danger
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				lbl := pg.Theme.Body1(components.TruncateString(item.ticket, 24))
+				lbl.Font.Weight = font.SemiBold
+				return lbl.Layout(gtx)
+			}, status.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(fmt.Sprintf("%s · %s", item.voteOption, pageutils.FormatDateOrTime(item.timestamp)))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}),
+	)
+}
+
+func (pg *ProposalDetails) layoutVoteReceiptsLink(gtx C) D {
+	if !pg.hasVoteReceipts {
+		return D{}
+	}
+	return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize16), values.String(values.StrVoteReceipts))
+		lbl.Color = pg.Theme.Color.Primary
+		return pg.voteReceipts.Layout(gtx, lbl.Layout)
+	})
+}
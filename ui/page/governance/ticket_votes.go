@@ -0,0 +1,106 @@
+package governance
+
+import (
+	"fmt"
+	"sort"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// maxTicketVotesShown is the number of the newest ticket votes listed.
+const maxTicketVotesShown = 50
+
+// showTicketVotesModal lists what the selected wallet's tickets voted for each
+// agenda, marking the choices that differ from the ones the tickets are set
+// to vote for now.
+func (pg *ConsensusPage) showTicketVotesModal() {
+	votes, err := pg.selectedDCRWallet.TicketVotes()
+	if err != nil {
+		errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+	if len(votes) > maxTicketVotesShown {
+		votes = votes[:maxTicketVotesShown]
+	}
+
+	votesModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrTicketVotes)).
+		UseCustomWidget(func(gtx C) D {
+			children := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					lbl := pg.Theme.Body2(values.String(values.StrTicketVotesMsg))
+					lbl.Color = pg.Theme.Color.GrayText2
+					return lbl.Layout(gtx)
+				}),
+			}
+			if len(votes) == 0 {
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.Theme.Body1(values.String(values.StrNoTicketVotes)).Layout)
+				}))
+			}
+			for _, vote := range votes {
+				vote := vote
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+						return pg.layoutTicketVote(gtx, vote)
+					})
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	pg.ParentWindow().ShowModal(votesModal)
+}
+
+func (pg *ConsensusPage) layoutTicketVote(gtx C, vote *dcr.TicketVote) D {
+	agendaIDs := make([]string, 0, len(vote.Choices))
+	for agendaID := range vote.Choices {
+		agendaIDs = append(agendaIDs, agendaID)
+	}
+	sort.Strings(agendaIDs)
+
+	mismatches := make(map[string]bool)
+	for _, agendaID := range vote.Mismatches() {
+		mismatches[agendaID] = true
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body1(components.TruncateString(vote.TicketHash, 24))
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.StringF(values.StrVotedAtBlock, vote.BlockHeight, pageutils.FormatDateOrTime(vote.Timestamp)))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}),
+	}
+	for _, agendaID := range agendaIDs {
+		agendaID := agendaID
+		children = append(children, layout.Rigid(func(gtx C) D {
+			choice := vote.Choices[agendaID]
+			if vote.Overrides[agendaID] {
+				choice = fmt.Sprintf("%s (%s)", choice, values.String(values.StrTicketChoice))
+			}
+			right := pg.Theme.Body2(choice)
+			if mismatches[agendaID] {
+				right.Text = fmt.Sprintf("%s, %s", choice, values.StringF(values.StrNowSetTo, vote.Expected[agendaID]))
+				right.Color = pg.Theme.Color.Danger
+			}
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return components.EndToEndRow(gtx, pg.Theme.Body2(agendaID).Layout, right.Layout)
+			})
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
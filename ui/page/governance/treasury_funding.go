@@ -0,0 +1,183 @@
+package governance
+
+import (
+	"math"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"github.com/decred/dcrd/dcrutil/v4"
+
+	"github.com/crypto-power/cryptopower/libwallet"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// fundingBarHeight is the height of the monthly spending bars.
+const fundingBarHeight = 8
+
+// loadTreasuryFunding fetches the treasury activity in the background and
+// relates it to the budgets of the synced approved proposals.
+func (pg *TreasuryPage) loadTreasuryFunding() {
+	go func() {
+		funding, err := pg.AssetsManager.TreasuryFunding()
+		if err != nil {
+			log.Errorf("Error loading treasury funding: %v", err)
+			return
+		}
+		pg.funding = funding
+		pg.ParentWindow().Reload()
+	}()
+}
+
+// dcrString formats an amount in DCR.
+func dcrString(coin float64) string {
+	amount, _ := dcrutil.NewAmount(coin)
+	return amount.String()
+}
+
+// usdString formats an amount in USD, followed by its value in DCR at the
+// current rate if it's known.
+func (pg *TreasuryPage) usdString(usd float64) string {
+	str := pageutils.FormatAsUSDString(pg.Printer, usd)
+	rate := pg.AssetsManager.RateSource.GetTicker(values.DCRUSDTMarket, true)
+	if rate != nil && rate.LastTradePrice > 0 {
+		str += " (" + dcrString(pageutils.USDToDCR(rate.LastTradePrice, usd)) + ")"
+	}
+	return str
+}
+
+func (pg *TreasuryPage) layoutTreasuryFunding(gtx C) D {
+	funding := pg.funding
+	if funding == nil {
+		return D{}
+	}
+
+	now := time.Now()
+	balance := dcrutil.Amount(funding.Balance)
+	yearRate := funding.SpendRate(12)
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize18), values.String(values.StrTreasuryFunding))
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrTreasuryFundingMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+		}),
+		pg.fundingRow(values.String(values.StrTreasuryBalance), balance.String()),
+		pg.fundingRow(values.String(values.StrAverageMonthlySpending),
+			values.StringF(values.StrSpendRateValue, dcrString(funding.SpendRate(3)), dcrString(yearRate))),
+	}
+	if yearRate > 0 {
+		months := int(math.Floor(balance.ToCoin() / yearRate))
+		children = append(children, pg.fundingRow(values.String(values.StrRunway), values.StringF(values.StrRunwayValue, months)))
+	}
+	children = append(children,
+		pg.fundingRow(values.String(values.StrRemainingBudgets), pg.usdString(funding.RemainingBudget(now))),
+		pg.fundingHeader(values.String(values.StrMonthlyActivity)),
+	)
+
+	var maxSpent float64
+	for _, month := range funding.Months {
+		maxSpent = math.Max(maxSpent, month.Spent)
+	}
+	for i := len(funding.Months) - 1; i >= 0; i-- {
+		month := funding.Months[i]
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return pg.layoutFundingMonth(gtx, month, maxSpent)
+			})
+		}))
+	}
+
+	children = append(children, pg.fundingHeader(values.String(values.StrApprovedBudgets)))
+	if len(funding.Proposals) == 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body1(values.String(values.StrNoFundedProposals))
+			lbl.Color = pg.Theme.Color.GrayText3
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+		}))
+	}
+	for _, proposal := range funding.Proposals {
+		proposal := proposal
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				return pg.layoutFundedProposal(gtx, proposal, now)
+			})
+		}))
+	}
+
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+}
+
+func (pg *TreasuryPage) fundingHeader(title string) layout.FlexChild {
+	return layout.Rigid(func(gtx C) D {
+		lbl := pg.Theme.Body1(title)
+		lbl.Font.Weight = font.SemiBold
+		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, lbl.Layout)
+	})
+}
+
+func (pg *TreasuryPage) fundingRow(title, value string) layout.FlexChild {
+	return layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+			return components.EndToEndRow(gtx, pg.Theme.Body2(title).Layout, pg.Theme.Body2(value).Layout)
+		})
+	})
+}
+
+func (pg *TreasuryPage) layoutFundingMonth(gtx C, month *libwallet.TreasuryMonth, maxSpent float64) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			activity := values.StringF(values.StrMonthActivity, dcrString(month.Received), dcrString(month.Spent),
+				pageutils.FormatAsUSDString(pg.Printer, month.Budgeted))
+			lbl := pg.Theme.Body2(activity)
+			lbl.Color = pg.Theme.Color.GrayText2
+			return components.EndToEndRow(gtx, pg.Theme.Body2(month.Time.UTC().Format("Jan 2006")).Layout, lbl.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			if maxSpent <= 0 || month.Spent <= 0 {
+				return D{}
+			}
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				card := pg.Theme.Card()
+				card.Color = pg.Theme.Color.Primary
+				return card.Layout(gtx, func(gtx C) D {
+					gtx.Constraints.Min.X = int(float64(gtx.Constraints.Max.X) * month.Spent / maxSpent)
+					gtx.Constraints.Min.Y = gtx.Dp(unit.Dp(fundingBarHeight))
+					return D{Size: gtx.Constraints.Min}
+				})
+			})
+		}),
+	)
+}
+
+func (pg *TreasuryPage) layoutFundedProposal(gtx C, proposal *libwallet.FundedProposal, now time.Time) D {
+	remaining := proposal.Remaining(now)
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			budget := pg.Theme.Body2(pageutils.FormatAsUSDString(pg.Printer, proposal.Budget))
+			return components.EndToEndRow(gtx, pg.Theme.Body1(proposal.Name).Layout, budget.Layout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			period := values.StringF(values.StrFundingPeriod, proposal.Domain,
+				time.Unix(proposal.StartDate, 0).UTC().Format("Jan 2006"), time.Unix(proposal.EndDate, 0).UTC().Format("Jan 2006"))
+			lbl := pg.Theme.Body2(period)
+			lbl.Color = pg.Theme.Color.GrayText2
+			right := pg.Theme.Body2("")
+			if remaining > 0 {
+				right.Text = values.StringF(values.StrBudgetRemaining, pageutils.FormatAsUSDString(pg.Printer, remaining))
+				right.Color = pg.Theme.Color.GrayText2
+			}
+			return components.EndToEndRow(gtx, lbl.Layout, right.Layout)
+		}),
+	)
+}
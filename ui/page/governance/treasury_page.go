@@ -46,14 +46,21 @@ type TreasuryPage struct {
 	selectedDCRWallet *dcr.Asset
 
 	treasuryItems []*components.TreasuryItem
+	tspendItems   []*tspendItem
+	// addedTSpends are the hashes of treasury spends added by the user that
+	// may not be pending in the mempool.
+	addedTSpends []string
+	funding      *libwallet.TreasuryFunding
 
 	listContainer      *widget.List
 	viewGovernanceKeys *cryptomaterial.Clickable
 	copyRedirectURL    *cryptomaterial.Clickable
 	redirectIcon       *cryptomaterial.Image
 
-	searchEditor cryptomaterial.Editor
-	infoButton   cryptomaterial.IconButton
+	searchEditor     cryptomaterial.Editor
+	tspendHashEditor cryptomaterial.Editor
+	addTSpendBtn     cryptomaterial.Button
+	infoButton       cryptomaterial.IconButton
 
 	isPolicyFetchInProgress bool
 	navigateToSettingsBtn   cryptomaterial.Button
@@ -78,6 +85,10 @@ func NewTreasuryPage(l *load.Load) *TreasuryPage {
 	pg.searchEditor = l.Theme.IconEditor(new(widget.Editor), values.String(values.StrSearch), l.Theme.Icons.SearchIcon, true)
 	pg.searchEditor.Editor.SingleLine, pg.searchEditor.Editor.Submit, pg.searchEditor.Bordered = true, true, false
 
+	pg.tspendHashEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrTSpendHash))
+	pg.tspendHashEditor.Editor.SingleLine = true
+	pg.addTSpendBtn = l.Theme.Button(values.String(values.StrAdd))
+
 	_, pg.infoButton = components.SubpageHeaderButtons(l)
 	pg.infoButton.Size = values.MarginPadding20
 	pg.navigateToSettingsBtn = pg.Theme.Button(values.StringF(values.StrEnableAPI, values.String(values.StrGovernance)))
@@ -96,8 +107,12 @@ func (pg *TreasuryPage) OnNavigatedTo() {
 	// a network call. Refresh the window once the call completes.
 	pg.PiKey = hex.EncodeToString(pg.AssetsManager.PiKeys()[0])
 
-	if pg.isTreasuryAPIAllowed() && pg.selectedDCRWallet != nil {
-		pg.FetchPolicies()
+	if pg.isTreasuryAPIAllowed() {
+		// The treasury funding doesn't depend on a wallet.
+		pg.loadTreasuryFunding()
+		if pg.selectedDCRWallet != nil {
+			pg.FetchPolicies()
+		}
 	}
 }
 
@@ -140,6 +155,8 @@ func (pg *TreasuryPage) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	pg.handleTSpendInteractions(gtx)
+
 	if pg.walletDropDown != nil && pg.walletDropDown.Changed(gtx) {
 		pg.selectedDCRWallet = pg.assetWallets[pg.walletDropDown.SelectedIndex()].(*dcr.Asset)
 		pg.FetchPolicies()
@@ -197,6 +214,7 @@ func (pg *TreasuryPage) FetchPolicies() {
 
 	go func() {
 		pg.treasuryItems = components.LoadPolicies(pg.Load, pg.selectedDCRWallet, pg.PiKey)
+		pg.tspendItems = pg.loadTSpends()
 		pg.isPolicyFetchInProgress = true
 		pg.ParentWindow().Reload()
 	}()
@@ -224,7 +242,21 @@ func (pg *TreasuryPage) Layout(gtx C) D {
 
 func (pg *TreasuryPage) layout(gtx C) D {
 	if pg.selectedDCRWallet == nil {
-		return pg.decredWalletRequired(gtx)
+		if pg.funding == nil {
+			return pg.decredWalletRequired(gtx)
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(pg.decredWalletRequired),
+			layout.Flexed(1, func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+					return pg.Theme.List(pg.listContainer).Layout(gtx, 1, func(gtx C, _ int) D {
+						return pg.Theme.Card().Layout(gtx, func(gtx C) D {
+							return layout.UniformInset(values.MarginPadding16).Layout(gtx, pg.layoutTreasuryFunding)
+						})
+					})
+				})
+			}),
+		)
 	}
 	return pg.Theme.Card().Layout(gtx, func(gtx C) D {
 		padding := values.MarginPadding24
@@ -307,18 +339,37 @@ func (pg *TreasuryPage) layoutContent(gtx C) D {
 	return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
 		list := layout.List{Axis: layout.Vertical}
 		return pg.Theme.List(pg.listContainer).Layout(gtx, 1, func(gtx C, _ int) D {
-			return list.Layout(gtx, len(pg.treasuryItems), func(gtx C, i int) D {
-				return layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
-					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-						layout.Rigid(pg.layoutPiKey),
-						layout.Rigid(func(gtx C) D {
-							return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
-								return components.TreasuryItemWidget(gtx, pg.Load, pg.treasuryItems[i])
-							})
-						}),
-					)
-				})
-			})
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return list.Layout(gtx, len(pg.treasuryItems), func(gtx C, i int) D {
+						return layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+							return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+								layout.Rigid(pg.layoutPiKey),
+								layout.Rigid(func(gtx C) D {
+									return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
+										return components.TreasuryItemWidget(gtx, pg.Load, pg.treasuryItems[i])
+									})
+								}),
+							)
+						})
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.Theme.Separator().Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.layoutTSpends)
+				}),
+				layout.Rigid(func(gtx C) D {
+					if pg.funding == nil {
+						return D{}
+					}
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.Theme.Separator().Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.layoutTreasuryFunding)
+				}),
+			)
 		})
 	})
 }
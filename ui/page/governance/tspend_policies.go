@@ -0,0 +1,212 @@
+package governance
+
+import (
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// tspendItem is a treasury spend the wallet's tickets can vote on, either
+// pending in the mempool or added by its hash.
+type tspendItem struct {
+	tspend            dcr.TSpend
+	optionsRadioGroup *widget.Enum
+	setChoiceButton   cryptomaterial.Button
+}
+
+// loadTSpends returns the pending treasury spends of the selected wallet,
+// followed by the ones added by hash that aren't pending.
+func (pg *TreasuryPage) loadTSpends() []*tspendItem {
+	tspends, err := pg.selectedDCRWallet.PendingTSpends()
+	if err != nil {
+		log.Errorf("Error loading treasury spends: %v", err)
+		return nil
+	}
+
+	pending := make(map[string]bool, len(tspends))
+	for _, tspend := range tspends {
+		pending[tspend.Hash] = true
+	}
+	for _, hash := range pg.addedTSpends {
+		if pending[hash] {
+			continue
+		}
+		policy, err := pg.selectedDCRWallet.TSpendPolicy(hash, "")
+		if err != nil {
+			continue
+		}
+		tspends = append(tspends, &dcr.TSpend{Hash: hash, Policy: policy})
+	}
+
+	items := make([]*tspendItem, len(tspends))
+	for i, tspend := range tspends {
+		button := pg.Theme.Button(values.String(values.StrSetChoice))
+		button.TextSize = pg.ConvertTextSize(values.TextSize16)
+		items[i] = &tspendItem{
+			tspend:            *tspend,
+			optionsRadioGroup: &widget.Enum{Value: tspend.Policy},
+			setChoiceButton:   button,
+		}
+	}
+	return items
+}
+
+func (pg *TreasuryPage) handleTSpendInteractions(gtx C) {
+	for _, item := range pg.tspendItems {
+		if item.setChoiceButton.Clicked(gtx) {
+			pg.updateTSpendPolicy(item)
+		}
+	}
+
+	if pg.addTSpendBtn.Clicked(gtx) {
+		pg.addTSpend()
+	}
+}
+
+// addTSpend lists the treasury spend whose hash was entered, so a policy can
+// be set for it before the wallet sees it in the mempool.
+func (pg *TreasuryPage) addTSpend() {
+	hash := strings.TrimSpace(pg.tspendHashEditor.Editor.Text())
+	if _, err := pg.selectedDCRWallet.TSpendPolicy(hash, ""); err != nil {
+		pg.tspendHashEditor.SetError(values.TranslateErr(err.Error()))
+		return
+	}
+
+	pg.tspendHashEditor.SetError("")
+	pg.tspendHashEditor.Editor.SetText("")
+	for _, added := range pg.addedTSpends {
+		if added == hash {
+			return
+		}
+	}
+	pg.addedTSpends = append(pg.addedTSpends, hash)
+	pg.FetchPolicies()
+}
+
+func (pg *TreasuryPage) updateTSpendPolicy(item *tspendItem) {
+	passwordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrConfirmVote)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			err := pg.selectedDCRWallet.SetTSpendPolicy(item.tspend.Hash, item.optionsRadioGroup.Value, "", password)
+			if err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+
+			pg.FetchPolicies()
+			infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrPolicySetSuccessful), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(infoModal)
+
+			pm.Dismiss()
+			return true
+		})
+	pg.ParentWindow().ShowModal(passwordModal)
+}
+
+func (pg *TreasuryPage) layoutTSpends(gtx C) D {
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Label(pg.ConvertTextSize(values.TextSize18), values.String(values.StrTreasurySpends))
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body2(values.String(values.StrTSpendPolicyMsg))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+		}),
+	}
+
+	if len(pg.tspendItems) == 0 {
+		children = append(children, layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body1(values.String(values.StrNoPendingTSpends))
+			lbl.Color = pg.Theme.Color.GrayText3
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, lbl.Layout)
+		}))
+	}
+	for _, item := range pg.tspendItems {
+		item := item
+		children = append(children, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return pg.layoutTSpendItem(gtx, item)
+			})
+		}))
+	}
+
+	children = append(children, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, pg.tspendHashEditor.Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding10}.Layout(gtx, pg.addTSpendBtn.Layout)
+				}),
+			)
+		})
+	}))
+
+	return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+}
+
+func (pg *TreasuryPage) layoutTSpendItem(gtx C, item *tspendItem) D {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(pg.Theme.Label(pg.ConvertTextSize(values.TextSize14), item.tspend.Hash).Layout),
+		layout.Rigid(func(gtx C) D {
+			if item.tspend.Amount == 0 {
+				return D{}
+			}
+			amount := pg.selectedDCRWallet.ToAmount(item.tspend.Amount).String()
+			lbl := pg.Theme.Body2(values.StringF(values.StrTSpendExpiry, amount, item.tspend.Expiry))
+			lbl.Color = pg.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return components.EndToEndRow(gtx, func(gtx C) D {
+				return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+					return layout.Flex{}.Layout(gtx, pg.layoutTSpendChoices(item)...)
+				})
+			}, func(gtx C) D {
+				return pg.layoutTSpendVoteAction(gtx, item)
+			})
+		}),
+	)
+}
+
+func (pg *TreasuryPage) layoutTSpendChoices(item *tspendItem) []layout.FlexChild {
+	voteChoices := [...]string{
+		strings.ToLower(values.String(values.StrYes)),
+		strings.ToLower(values.String(values.StrNo)),
+		strings.ToLower(values.String(values.StrAbstain)),
+	}
+	items := make([]layout.FlexChild, 0, len(voteChoices))
+	for _, voteChoice := range voteChoices {
+		radioBtn := pg.Theme.RadioButton(item.optionsRadioGroup, voteChoice, voteChoice, pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary)
+		radioBtn.TextSize = pg.ConvertTextSize(values.TextSize16)
+		items = append(items, layout.Rigid(radioBtn.Layout))
+	}
+	return items
+}
+
+func (pg *TreasuryPage) layoutTSpendVoteAction(gtx C, item *tspendItem) D {
+	gtx.Constraints.Min.X, gtx.Constraints.Max.X = gtx.Dp(values.MarginPadding100), gtx.Dp(values.MarginPadding150)
+	item.setChoiceButton.Background = pg.Theme.Color.Gray3
+	item.setChoiceButton.SetEnabled(false)
+
+	if item.optionsRadioGroup.Value != "" && item.optionsRadioGroup.Value != item.tspend.Policy {
+		item.setChoiceButton.Background = pg.Theme.Color.Primary
+		item.setChoiceButton.SetEnabled(true)
+	}
+	return item.setChoiceButton.Layout(gtx)
+}
@@ -32,6 +32,7 @@ type AccountMixerPage struct {
 	settingsCollapsible *cryptomaterial.Collapsible
 	unmixedAccount      *cryptomaterial.Clickable
 	mixedAccount        *cryptomaterial.Clickable
+	mixingWindows       *cryptomaterial.Clickable
 	toggleMixer         *cryptomaterial.Switch
 	mixerProgress       cryptomaterial.ProgressBarStyle
 
@@ -40,6 +41,7 @@ type AccountMixerPage struct {
 	totalWalletBalance sharedW.AssetAmount
 
 	allAccount []preference.ItemPreference
+	mixerStats *dcr.MixerStats
 
 	mixerCompleted bool
 }
@@ -54,6 +56,7 @@ func NewAccountMixerPage(l *load.Load, wallet *dcr.Asset) *AccountMixerPage {
 		settingsCollapsible: l.Theme.Collapsible(),
 		unmixedAccount:      l.Theme.NewClickable(false),
 		mixedAccount:        l.Theme.NewClickable(false),
+		mixingWindows:       l.Theme.NewClickable(false),
 		pageContainer:       layout.List{Axis: layout.Vertical},
 	}
 }
@@ -75,6 +78,7 @@ func (pg *AccountMixerPage) OnNavigatedTo() {
 	pg.totalWalletBalance = totalBalance.Total
 	// get balance information
 	pg.getMixerBalance()
+	pg.loadMixerStats()
 }
 
 func (pg *AccountMixerPage) getMixerBalance() {
@@ -282,6 +286,7 @@ func (pg *AccountMixerPage) mixerSettings(l *load.Load) layout.FlexChild {
 								return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 									layout.Rigid(pg.bottomSectionLabel(pg.mixedAccount, values.String(values.StrMixedAccount))),
 									layout.Rigid(pg.bottomSectionLabel(pg.unmixedAccount, values.String(values.StrUnmixedAccount))),
+									layout.Rigid(pg.bottomSectionLabel(pg.mixingWindows, pg.mixingWindowsLabel())),
 								)
 							})
 						},
@@ -301,9 +306,11 @@ func (pg *AccountMixerPage) Layout(gtx layout.Context) layout.Dimensions {
 			return layout.UniformInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					pg.mixerHeaderContent(),
+					pg.mixingWindowStatus(),
 					pg.balanceInfo(values.String(values.StrMixed), pg.mixedBalance.String(), pg.Theme.Icons.MixedTxIcon),
 					pg.mixerImage(),
 					pg.balanceInfo(values.String(values.StrUnmixed), pg.unmixedBalance.String(), pg.Theme.Icons.UnmixedTxIcon),
+					pg.mixerStatsSection(),
 					pg.mixerSettings(pg.Load),
 				)
 			})
@@ -389,6 +396,10 @@ func (pg *AccountMixerPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(mixedAccountModal)
 	}
 
+	if pg.mixingWindows.Clicked(gtx) {
+		pg.showMixingWindowsModal()
+	}
+
 	if pg.unmixedAccount.Clicked(gtx) {
 		name, err := pg.dcrWallet.AccountName(pg.dcrWallet.UnmixedAccountNumber())
 		if err != nil {
@@ -469,6 +480,7 @@ func (pg *AccountMixerPage) listenForMixerNotifications() {
 		OnAccountMixerEnded: func(_ int) {
 			pg.mixerCompleted = true
 			pg.getMixerBalance()
+			pg.loadMixerStats()
 			pg.ParentWindow().Reload()
 		},
 	}
@@ -482,6 +494,7 @@ func (pg *AccountMixerPage) listenForMixerNotifications() {
 	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
 		OnBlockAttached: func(_ int, _ int32) {
 			pg.getMixerBalance()
+			pg.loadMixerStats()
 			pg.ParentWindow().Reload()
 		},
 	}
@@ -0,0 +1,182 @@
+package privacy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// maxMixerDaysShown is the number of days listed in the mixer statistics.
+const maxMixerDaysShown = 7
+
+func (pg *AccountMixerPage) loadMixerStats() {
+	stats, err := pg.dcrWallet.MixerStats()
+	if err != nil {
+		log.Errorf("Error loading mixer statistics: %v", err)
+		return
+	}
+	pg.mixerStats = stats
+}
+
+// mixingWindowStatus tells the user why a running mixer isn't mixing.
+func (pg *AccountMixerPage) mixingWindowStatus() layout.FlexChild {
+	return layout.Rigid(func(gtx C) D {
+		if !pg.dcrWallet.IsWaitingForMixingWindow() {
+			return D{}
+		}
+		lbl := pg.Theme.Label(values.TextSize14, values.String(values.StrWaitingForMixingWindow))
+		lbl.Color = pg.Theme.Color.GrayText2
+		return layout.Inset{Bottom: values.MarginPadding15}.Layout(gtx, lbl.Layout)
+	})
+}
+
+func (pg *AccountMixerPage) mixerStatsRow(label, value string) layout.FlexChild {
+	return layout.Rigid(func(gtx C) D {
+		return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+			left := pg.Theme.Label(values.TextSize14, label)
+			left.Color = pg.Theme.Color.GrayText2
+			return components.EndToEndRow(gtx, left.Layout, pg.Theme.Label(values.TextSize14, value).Layout)
+		})
+	})
+}
+
+// mixerStatsSection shows how much and how often the wallet mixed, and how
+// much of its balance is still to be mixed.
+func (pg *AccountMixerPage) mixerStatsSection() layout.FlexChild {
+	return layout.Rigid(func(gtx C) D {
+		stats := pg.mixerStats
+		if stats == nil {
+			return D{}
+		}
+
+		rows := []layout.FlexChild{
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Left: values.MarginPadding10, Right: values.MarginPadding10, Top: values.MarginPadding15}.Layout(gtx, pg.Theme.Separator().Layout)
+			}),
+			layout.Rigid(func(gtx C) D {
+				txt := pg.Theme.Label(values.TextSize16, values.String(values.StrMixerStatistics))
+				txt.Color = pg.Theme.Color.GrayText3
+				return layout.Inset{Top: values.MarginPadding15}.Layout(gtx, txt.Layout)
+			}),
+		}
+		if stats.Mixes == 0 {
+			rows = append(rows, pg.mixerStatsRow(values.String(values.StrMixes), values.String(values.StrNoMixesYet)))
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+		}
+
+		rows = append(rows,
+			pg.mixerStatsRow(values.String(values.StrMixes), values.StringF(values.StrMixesPerDay, stats.Mixes, stats.MixesPerDay)),
+			pg.mixerStatsRow(values.String(values.StrMixedAmount), pg.dcrWallet.ToAmount(stats.MixedAmount).String()),
+			pg.mixerStatsRow(values.String(values.StrMixFeesPaid), pg.dcrWallet.ToAmount(stats.FeesPaid).String()),
+			pg.mixerStatsRow(values.String(values.StrUnmixedRemaining), fmt.Sprintf("%.1f%%", stats.UnmixedShare*100)),
+			pg.mixerStatsRow(values.String(values.StrDenominations), pg.denominationsText(stats)),
+		)
+		for i, day := range stats.Days {
+			if i == maxMixerDaysShown {
+				break
+			}
+			rows = append(rows, pg.mixerStatsRow(day.Day.Format("Jan 02"),
+				values.StringF(values.StrMixesOnDay, day.Mixes, pg.dcrWallet.ToAmount(day.MixedAmount).String())))
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+	})
+}
+
+// denominationsText lists the mixed denominations, largest first, with the
+// number of outputs of each.
+func (pg *AccountMixerPage) denominationsText(stats *dcr.MixerStats) string {
+	denominations := make([]int64, 0, len(stats.Denominations))
+	for denomination := range stats.Denominations {
+		denominations = append(denominations, denomination)
+	}
+	sort.Slice(denominations, func(i, j int) bool { return denominations[i] > denominations[j] })
+
+	parts := make([]string, len(denominations))
+	for i, denomination := range denominations {
+		parts[i] = fmt.Sprintf("%s × %d", pg.dcrWallet.ToAmount(denomination).String(), stats.Denominations[denomination])
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatMixingWindows(windows []dcr.MixingWindow) string {
+	parts := make([]string, len(windows))
+	for i, window := range windows {
+		parts[i] = fmt.Sprintf("%d-%d", window.FromHour, window.ToHour)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseMixingWindows parses comma separated start-end hours, e.g. "22-6, 12-14".
+func parseMixingWindows(text string) ([]dcr.MixingWindow, bool) {
+	var windows []dcr.MixingWindow
+	for _, part := range strings.Split(text, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		from, to, found := strings.Cut(part, "-")
+		if !found {
+			return nil, false
+		}
+		fromHour, err := strconv.ParseInt(strings.TrimSpace(from), 10, 32)
+		if err != nil || fromHour < 0 || fromHour > 23 {
+			return nil, false
+		}
+		toHour, err := strconv.ParseInt(strings.TrimSpace(to), 10, 32)
+		if err != nil || toHour < 0 || toHour > 23 {
+			return nil, false
+		}
+		windows = append(windows, dcr.MixingWindow{FromHour: int32(fromHour), ToHour: int32(toHour)})
+	}
+	return windows, true
+}
+
+func (pg *AccountMixerPage) mixingWindowsLabel() string {
+	windows := pg.dcrWallet.MixingWindows()
+	summary := values.String(values.StrMixAnyTime)
+	if len(windows) > 0 {
+		summary = formatMixingWindows(windows)
+	}
+	return values.String(values.StrMixingWindows) + ": " + summary
+}
+
+func (pg *AccountMixerPage) showMixingWindowsModal() {
+	windowsEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrMixingWindowsHint))
+	windowsEditor.Editor.SingleLine = true
+	windowsEditor.Editor.SetText(formatMixingWindows(pg.dcrWallet.MixingWindows()))
+
+	windowsModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrMixingWindows)).
+		UseCustomWidget(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pg.Theme.Body1(values.String(values.StrMixingWindowsMsg)).Layout),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, windowsEditor.Layout)
+				}),
+			)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrSave)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			windows, ok := parseMixingWindows(windowsEditor.Editor.Text())
+			if !ok {
+				windowsEditor.SetError(values.String(values.StrInvalidMixingWindows))
+				return false
+			}
+			if err := pg.dcrWallet.SetMixingWindows(windows); err != nil {
+				windowsEditor.SetError(values.TranslateErr(err.Error()))
+				return false
+			}
+			return true
+		})
+	pg.ParentWindow().ShowModal(windowsModal)
+}
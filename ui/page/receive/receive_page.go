@@ -16,6 +16,7 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -46,6 +47,7 @@ type Page struct {
 	isNewAddr       bool
 	currentAddress  string
 	qrImage         *image.Image
+	amountEditor    cryptomaterial.Editor
 	newAddr, copy   *cryptomaterial.Clickable
 	info            cryptomaterial.IconButton
 	card            cryptomaterial.Card
@@ -88,6 +90,10 @@ func NewReceivePage(l *load.Load, wallet sharedW.Asset) *Page {
 
 	pg.info.Inset, pg.info.Size = layout.UniformInset(values.MarginPadding5), values.MarginPadding20
 
+	pg.amountEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrRequestedAmount))
+	pg.amountEditor.Editor.SingleLine = true
+	pg.amountEditor.IsTitleLabel = false
+
 	_, pg.infoButton = components.SubpageHeaderButtons(l)
 	if wallet == nil {
 		pg.modalLayout = l.Theme.ModalFloatTitle(values.String(values.StrReceive), pg.IsMobileView(), nil)
@@ -181,8 +187,32 @@ func (pg *Page) OnNavigatedTo() {
 	}
 }
 
+// paymentURI returns the payment URI for the current address and the requested
+// amount, if any. The bare address is returned for assets without a URI
+// scheme.
+func (pg *Page) paymentURI() string {
+	if _, err := uri.Scheme(pg.selectedWallet.GetAssetType()); err != nil {
+		return pg.currentAddress
+	}
+
+	paymentURI := &uri.PaymentURI{
+		AssetType: pg.selectedWallet.GetAssetType(),
+		Address:   pg.currentAddress,
+	}
+	pg.amountEditor.SetError("")
+	if amountText := strings.TrimSpace(pg.amountEditor.Editor.Text()); amountText != "" {
+		amount, err := uri.ParseAmount(amountText)
+		if err != nil {
+			pg.amountEditor.SetError(values.String(values.StrInvalidAmount))
+		} else {
+			paymentURI.Amount = amount
+		}
+	}
+	return paymentURI.String()
+}
+
 func (pg *Page) generateQRForAddress() {
-	qrCode, err := qrcode.New(pg.currentAddress, qrcode.WithLogoImage(pg.getSelectedWalletLogo()))
+	qrCode, err := qrcode.New(pg.paymentURI(), qrcode.WithLogoImage(pg.getSelectedWalletLogo()))
 	if err != nil {
 		log.Error("Error generating address qrCode: " + err.Error())
 		return
@@ -259,6 +289,12 @@ func (pg *Page) contentLayout(gtx C) D {
 								return pg.accountDropdown.Layout(gtx, values.String(values.StrAccount))
 							})
 						}),
+						layout.Rigid(func(gtx C) D {
+							if !pg.selectedWallet.IsSynced() {
+								return D{}
+							}
+							return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.amountEditor.Layout)
+						}),
 						layout.Rigid(func(gtx C) D {
 							return components.VerticalInset(values.MarginPadding24).Layout(gtx, pg.Theme.Separator().Layout)
 						}),
@@ -453,6 +489,16 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		pg.isNewAddr = false
 	}
 
+	for {
+		event, ok := pg.amountEditor.Editor.Update(gtx)
+		if !ok {
+			break
+		}
+		if _, ok := event.(widget.ChangeEvent); ok {
+			pg.generateQRForAddress()
+		}
+	}
+
 	if pg.newAddr.Clicked(gtx) {
 		newAddr, err := pg.generateNewAddress()
 		if err != nil {
@@ -500,10 +546,16 @@ generateAddress:
 
 func (pg *Page) handleCopyEvent(gtx C) {
 	// Prevent copying again if the timer hasn't expired
-	if (pg.copy.Clicked(gtx) || pg.qrCopyButton.Clicked(gtx) || pg.addressCopyButton.Clicked(gtx)) && !pg.isCopying {
+	if (pg.copy.Clicked(gtx) || pg.addressCopyButton.Clicked(gtx)) && !pg.isCopying {
 		gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(pg.currentAddress))})
 		pg.Toast.Notify(values.String(values.StrCopied))
 	}
+
+	// The QR code encodes the payment URI, copy the same.
+	if pg.qrCopyButton.Clicked(gtx) && !pg.isCopying {
+		gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(pg.paymentURI()))})
+		pg.Toast.Notify(values.String(values.StrCopied))
+	}
 }
 
 // OnNavigatedFrom is called when the page is about to be removed from
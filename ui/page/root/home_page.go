@@ -20,6 +20,7 @@ import (
 	"github.com/crypto-power/cryptopower/app"
 	"github.com/crypto-power/cryptopower/appos"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -50,6 +51,7 @@ type HomePage struct {
 	ctx                 context.Context
 	ctxCancel           context.CancelFunc
 	sendReceiveNavItems []components.NavBarItem
+	contactsChecked     bool
 
 	navigationTab          *cryptomaterial.Tab
 	appLevelSettingsButton *cryptomaterial.Clickable
@@ -191,6 +193,22 @@ func (hp *HomePage) OnNavigatedTo() {
 		hp.CurrentPage().OnNavigatedTo()
 	}
 
+	// The address book is shared by the networks but contacts saved on
+	// another network can't be paid to. The home page is recreated when the
+	// network is changed.
+	if !hp.contactsChecked {
+		hp.contactsChecked = true
+		if invalid, err := hp.AssetsManager.InvalidContacts(); err != nil {
+			log.Errorf("error validating address book contacts: %v", err)
+		} else if len(invalid) > 0 {
+			hp.Toast.NotifyError(values.StringF(values.StrInvalidContactsWarning, len(invalid)))
+		}
+	}
+
+	if paymentURI := hp.TakePaymentURI(); paymentURI != nil {
+		hp.openPaymentURI(paymentURI)
+	}
+
 	// Initiate the auto sync for all wallets with autosync set.
 	allWallets := hp.AssetsManager.AllWallets()
 	for _, wallet := range allWallets {
@@ -223,6 +241,17 @@ func (hp *HomePage) OnNavigatedTo() {
 	})
 }
 
+// openPaymentURI displays the send form filled with the payment request.
+func (hp *HomePage) openPaymentURI(paymentURI *uri.PaymentURI) {
+	sendPage := send.NewSendPage(hp.Load, nil)
+	if !sendPage.SetPaymentURI(paymentURI) {
+		errModal := modal.NewErrorModal(hp.Load, values.StringF(values.StrNoWalletForPaymentURI, paymentURI.AssetType), modal.DefaultClickFunc())
+		hp.ParentWindow().ShowModal(errModal)
+		return
+	}
+	hp.ParentWindow().ShowModal(sendPage)
+}
+
 // Call the update function for subpages when there is a new tx
 func (hp *HomePage) UpdateSubpageWhenHasNewTx(walletID int) {
 	switch hp.CurrentPageID() {
@@ -0,0 +1,185 @@
+package send
+
+import (
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/libwallet/addressbook"
+	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// contactPickerModal lists the address book contacts of an asset, lets the
+// user pick one to send to and add new ones. The address of a new contact is
+// prefilled with the address the user entered, if it isn't saved yet.
+type contactPickerModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	assetType       libUtil.AssetType
+	contacts        []*addressbook.Contact
+	invalidContacts map[int]bool
+	deleteButtons   []*cryptomaterial.Clickable
+	contactList     *cryptomaterial.ClickableList
+
+	nameEditor    cryptomaterial.Editor
+	addressEditor cryptomaterial.Editor
+	addButton     cryptomaterial.Button
+
+	contactSelected func(*addressbook.Contact)
+}
+
+func newContactPickerModal(l *load.Load, assetType libUtil.AssetType, address string, contactSelected func(*addressbook.Contact)) *contactPickerModal {
+	cm := &contactPickerModal{
+		Load:            l,
+		Modal:           l.Theme.ModalFloatTitle("contact_picker_modal", l.IsMobileView(), nil),
+		assetType:       assetType,
+		contactList:     l.Theme.NewClickableList(layout.Vertical),
+		nameEditor:      l.Theme.Editor(new(widget.Editor), values.String(values.StrContactName)),
+		addressEditor:   l.Theme.Editor(new(widget.Editor), values.String(values.StrAddress)),
+		addButton:       l.Theme.Button(values.String(values.StrAddContact)),
+		contactSelected: contactSelected,
+	}
+	cm.nameEditor.Editor.SingleLine = true
+	cm.addressEditor.Editor.SingleLine = true
+	cm.addButton.SetEnabled(false)
+
+	if address != "" && l.AssetsManager.AddressBook.ContactWithAddress(assetType, address) == nil {
+		cm.addressEditor.Editor.SetText(address)
+	}
+
+	return cm
+}
+
+func (cm *contactPickerModal) OnResume() {
+	cm.loadContacts()
+}
+
+func (cm *contactPickerModal) loadContacts() {
+	contacts, err := cm.AssetsManager.AddressBook.Contacts(cm.assetType)
+	if err != nil {
+		log.Errorf("error loading contacts: %v", err)
+		return
+	}
+
+	cm.contacts = contacts
+	cm.invalidContacts = make(map[int]bool)
+	cm.deleteButtons = make([]*cryptomaterial.Clickable, len(contacts))
+	for i, contact := range contacts {
+		cm.deleteButtons[i] = cm.Theme.NewClickable(false)
+		if !cm.AssetsManager.IsContactValid(contact) {
+			cm.invalidContacts[contact.ID] = true
+		}
+	}
+}
+
+func (cm *contactPickerModal) OnDismiss() {}
+
+func (cm *contactPickerModal) Handle(gtx C) {
+	cm.addButton.SetEnabled(utils.EditorsNotEmpty(cm.nameEditor.Editor, cm.addressEditor.Editor))
+
+	if cm.nameEditor.Changed() || cm.addressEditor.Changed() {
+		cm.addressEditor.SetError("")
+	}
+
+	if cm.addButton.Clicked(gtx) {
+		_, err := cm.AssetsManager.AddContact(cm.nameEditor.Editor.Text(), cm.assetType, cm.addressEditor.Editor.Text())
+		if err != nil {
+			cm.addressEditor.SetError(values.TranslateErr(err.Error()))
+		} else {
+			cm.nameEditor.Editor.SetText("")
+			cm.addressEditor.Editor.SetText("")
+			cm.loadContacts()
+		}
+	}
+
+	for i, deleteButton := range cm.deleteButtons {
+		if deleteButton.Clicked(gtx) {
+			if err := cm.AssetsManager.AddressBook.Delete(cm.contacts[i].ID); err != nil {
+				cm.Toast.NotifyError(err.Error())
+			}
+			cm.loadContacts()
+			break
+		}
+	}
+
+	if clicked, index := cm.contactList.ItemClicked(); clicked {
+		contact := cm.contacts[index]
+		if cm.invalidContacts[contact.ID] {
+			cm.Toast.NotifyError(values.String(values.StrContactAddressInvalid))
+		} else {
+			cm.contactSelected(contact)
+			cm.Dismiss()
+		}
+	}
+
+	if cm.Modal.BackdropClicked(gtx, true) {
+		cm.Dismiss()
+	}
+}
+
+func (cm *contactPickerModal) Layout(gtx C) D {
+	textSize14 := values.TextSizeTransform(cm.IsMobileView(), values.TextSize14)
+	textSize16 := values.TextSizeTransform(cm.IsMobileView(), values.TextSize16)
+	textSize20 := values.TextSizeTransform(cm.IsMobileView(), values.TextSize20)
+	return cm.Modal.Layout(gtx, []layout.Widget{
+		func(gtx C) D {
+			title := cm.Theme.Label(textSize20, values.String(values.StrAddressBook))
+			title.Font.Weight = font.SemiBold
+			return title.Layout(gtx)
+		},
+		func(gtx C) D {
+			if len(cm.contacts) == 0 {
+				noContacts := cm.Theme.Label(textSize14, values.String(values.StrNoContacts))
+				noContacts.Color = cm.Theme.Color.GrayText2
+				return noContacts.Layout(gtx)
+			}
+
+			return cm.contactList.Layout(gtx, len(cm.contacts), func(gtx C, i int) D {
+				cm.Modal.ShowScrollbar(true)
+				contact := cm.contacts[i]
+				return layout.Inset{Top: values.MarginPadding8, Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						layout.Flexed(1, func(gtx C) D {
+							return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+								layout.Rigid(cm.Theme.Label(textSize16, contact.Name).Layout),
+								layout.Rigid(func(gtx C) D {
+									address := cm.Theme.Label(textSize14, contact.Address)
+									address.Color = cm.Theme.Color.GrayText2
+									return address.Layout(gtx)
+								}),
+								layout.Rigid(func(gtx C) D {
+									if !cm.invalidContacts[contact.ID] {
+										return D{}
+									}
+									warning := cm.Theme.Label(textSize14, values.String(values.StrContactAddressInvalid))
+									warning.Color = cm.Theme.Color.Danger
+									return warning.Layout(gtx)
+								}),
+							)
+						}),
+						layout.Rigid(func(gtx C) D {
+							return cm.deleteButtons[i].Layout(gtx, cm.Theme.NewIcon(cm.Theme.Icons.DeleteIcon).Layout20dp)
+						}),
+					)
+				})
+			})
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(cm.nameEditor.Layout),
+				layout.Rigid(func(gtx C) D {
+					return components.VerticalInset(values.MarginPadding8).Layout(gtx, cm.addressEditor.Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.E.Layout(gtx, cm.addButton.Layout)
+				}),
+			)
+		},
+	})
+}
@@ -42,6 +42,10 @@ func (pg *Page) initLayoutWidgets() {
 	pg.closeButton.TextSize = values.TextSize16
 	pg.closeButton.Inset = layout.Inset{Top: values.MarginPadding12, Bottom: values.MarginPadding12}
 
+	pg.importTxButton = pg.Theme.OutlineButton(values.String(values.StrImportTransaction))
+	pg.importTxButton.TextSize = values.TextSize16
+	pg.importTxButton.Inset = layout.Inset{Top: values.MarginPadding12, Bottom: values.MarginPadding12}
+
 	pg.toCoinSelection = pg.Theme.NewClickable(false)
 }
 
@@ -342,6 +346,14 @@ func (pg *Page) balanceSection(gtx C) D {
 							}
 							return pg.contentRow(gtx, values.String(values.StrBalanceAfter), balanceAfterSendText)
 						}),
+						layout.Rigid(func(gtx C) D {
+							if pg.addressReuseWarning == "" {
+								return D{}
+							}
+							lbl := pg.Theme.Label(values.TextSize14, pg.addressReuseWarning)
+							lbl.Color = pg.Theme.Color.Danger
+							return layout.Inset{Top: values.MarginPadding12}.Layout(gtx, lbl.Layout)
+						}),
 					)
 				})
 			}),
@@ -362,10 +374,26 @@ func (pg *Page) balanceSection(gtx C) D {
 				}))
 				return layout.Flex{}.Layout(gtx, flexChilds...)
 			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.selectedWallet == nil || !SupportsOfflineSigning(pg.selectedWallet) {
+					return D{}
+				}
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, pg.importTxButton.Layout)
+			}),
 		)
 	})
 }
 
+// updateNextButtonText names the next button after what it does for the
+// selected wallet.
+func (pg *Page) updateNextButtonText() {
+	pg.nextButton.Text = values.String(values.StrNext)
+	if pg.exportsUnsignedTx() {
+		pg.nextButton.Text = values.String(values.StrExportUnsignedTx)
+	}
+}
+
 func (pg *Page) sectionWrapper(gtx C, body layout.Widget) D {
 	margin16 := values.MarginPadding16
 	if pg.modalLayout != nil {
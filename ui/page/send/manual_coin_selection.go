@@ -30,8 +30,9 @@ const (
 // UTXOInfo defines a utxo record associated with a specific row in the table view.
 type UTXOInfo struct {
 	*sharedW.UnspentOutput
-	checkbox    cryptomaterial.CheckBoxStyle
-	addressCopy *cryptomaterial.Clickable
+	checkbox     cryptomaterial.CheckBoxStyle
+	addressCopy  *cryptomaterial.Clickable
+	freezeToggle *cryptomaterial.Clickable
 }
 
 type AccountUTXOInfo struct {
@@ -244,6 +245,7 @@ func (pg *ManualCoinSelectionPage) fetchAccountsInfo() error {
 			UnspentOutput: row,
 			checkbox:      pg.Theme.CheckBox(new(widget.Bool), ""),
 			addressCopy:   pg.Theme.NewClickable(false),
+			freezeToggle:  pg.Theme.NewClickable(false),
 		}
 
 		info.checkbox.CheckBoxStyle.Size = 20
@@ -325,6 +327,10 @@ func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 	// Update Summary information as the last section when handling events.
 	for i := 0; i < len(pg.accountUTXOs.Details); i++ {
 		record := pg.accountUTXOs.Details[i]
+		if record.freezeToggle.Clicked(gtx) {
+			pg.toggleFrozen(record)
+		}
+
 		if record.checkbox.CheckBox.Update(gtx) {
 			if record.checkbox.CheckBox.Value {
 				pg.selectedUTXOrows = append(pg.selectedUTXOrows, record.UnspentOutput)
@@ -345,6 +351,29 @@ func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 	}
 }
 
+// toggleFrozen freezes the output of record if it isn't frozen and unfreezes
+// it otherwise. Frozen outputs are left out of automatic coin selection but
+// can still be selected here.
+func (pg *ManualCoinSelectionPage) toggleFrozen(record *UTXOInfo) {
+	wallet := pg.sendPage.selectedWallet
+	if record.Frozen {
+		if err := wallet.UnfreezeOutput(record.TxID, record.Vout); err != nil {
+			pg.Toast.NotifyError(err.Error())
+			return
+		}
+		record.Frozen = false
+		pg.Toast.Notify(values.String(values.StrOutputUnfrozen))
+		return
+	}
+
+	if err := wallet.FreezeOutput(record.TxID, record.Vout); err != nil {
+		pg.Toast.NotifyError(err.Error())
+		return
+	}
+	record.Frozen = true
+	pg.Toast.Notify(values.String(values.StrOutputFrozen))
+}
+
 func (pg *ManualCoinSelectionPage) updateSummaryInfo() {
 	pg.txSize.Text = pg.computeUTXOsSize()
 	pg.selectedUTXOs.Text = fmt.Sprintf("%d", len(pg.selectedUTXOrows))
@@ -586,7 +615,16 @@ func (pg *ManualCoinSelectionPage) accountListItemsSection(gtx C, utxos []*UTXOI
 							}
 
 							addressComponent := func(gtx C) D {
-								return v.addressCopy.Layout(gtx, addresslabel.label.Layout)
+								return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+									layout.Rigid(func(gtx C) D {
+										return v.addressCopy.Layout(gtx, addresslabel.label.Layout)
+									}),
+									layout.Rigid(func(gtx C) D {
+										return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+											return v.freezeToggle.Layout(gtx, pg.frozenLabel(v.Frozen).Layout)
+										})
+									}),
+								)
 							}
 							return pg.rowItemsSection(gtx, checkButton, amountLabel, nil, addressComponent,
 								nil, confirmationsLabel, nil, dateLabel)
@@ -607,6 +645,18 @@ func (pg *ManualCoinSelectionPage) accountListItemsSection(gtx C, utxos []*UTXOI
 	})
 }
 
+// frozenLabel returns the label of the freeze toggle of an output.
+func (pg *ManualCoinSelectionPage) frozenLabel(frozen bool) cryptomaterial.Label {
+	if frozen {
+		lbl := pg.Theme.Label(values.TextSize12, values.String(values.StrFrozen))
+		lbl.Color = pg.Theme.Color.Danger
+		return lbl
+	}
+	lbl := pg.Theme.Label(values.TextSize12, values.String(values.StrFreeze))
+	lbl.Color = pg.Theme.Color.GrayText2
+	return lbl
+}
+
 func (pg *ManualCoinSelectionPage) rowItemsSection(gtx C, components ...interface{}) D {
 	getRowItem := func(index int) layout.Widget {
 		var widget layout.Widget
@@ -0,0 +1,184 @@
+package send
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// psbtWallet is implemented by the BTC and LTC wallets, whose transactions are
+// moved between wallets as PSBTs.
+type psbtWallet interface {
+	SaveUnsignedPSBT(filePath string) error
+	BroadcastPSBT(data []byte, privatePassphrase, transactionLabel string) (string, error)
+}
+
+// externalSigningWallet is implemented by the DCR wallets. Watch-only wallets
+// export the unsigned transaction, a seeded wallet signs it without
+// broadcasting and the watch-only wallet broadcasts the signed transaction.
+type externalSigningWallet interface {
+	ExportUnsignedTransaction() ([]byte, error)
+	SignExternalTransaction(unsignedTxData []byte, privatePassphrase string) (string, error)
+	BroadcastSignedTransaction(signedTxHex, transactionLabel string) (string, error)
+}
+
+// SupportsOfflineSigning returns true if the transactions authored by wallet
+// can be exported unsigned, signed by another wallet holding the keys and
+// imported back to be broadcast. It's how watch-only wallets send.
+func SupportsOfflineSigning(wallet sharedW.Asset) bool {
+	switch wallet.(type) {
+	case psbtWallet, externalSigningWallet:
+		return true
+	}
+	return false
+}
+
+// exportsUnsignedTx returns true if the selected wallet can't sign, in which
+// case the transaction authored on this page is exported unsigned.
+func (pg *Page) exportsUnsignedTx() bool {
+	return pg.selectedWallet != nil && pg.selectedWallet.IsWatchingOnlyWallet() &&
+		SupportsOfflineSigning(pg.selectedWallet)
+}
+
+// exportUnsignedTx saves the transaction authored on this page, unsigned, to
+// the exports folder.
+func (pg *Page) exportUnsignedTx() {
+	var fileName string
+	var err error
+	switch w := pg.selectedWallet.(type) {
+	case psbtWallet:
+		fileName, err = pg.exportFileName("unsigned", "psbt")
+		if err == nil {
+			err = w.SaveUnsignedPSBT(fileName)
+		}
+	case externalSigningWallet:
+		var data []byte
+		if data, err = w.ExportUnsignedTransaction(); err == nil {
+			fileName, err = pg.exportFileName("unsigned", "json")
+		}
+		if err == nil {
+			err = os.WriteFile(fileName, data, libUtil.UserFilePerm)
+		}
+	}
+	if err != nil {
+		errModal := modal.NewErrorModal(pg.Load, values.TranslateErr(err.Error()), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrUnsignedTxExported, fileName), modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(infoModal)
+}
+
+// exportFileName returns the path of a new file in the exports folder for a
+// transaction of the selected wallet, creating the folder if needed.
+func (pg *Page) exportFileName(kind, ext string) (string, error) {
+	assetType := strings.ToLower(string(pg.selectedWallet.GetAssetType()))
+	exportsDir := filepath.Join(pg.AssetsManager.RootDir(), "exports")
+	if err := os.MkdirAll(exportsDir, libUtil.UserFilePerm); err != nil {
+		return "", err
+	}
+	return filepath.Join(exportsDir, fmt.Sprintf("%s_%s_tx_%d.%s", kind, assetType, time.Now().Unix(), ext)), nil
+}
+
+// showImportTxModal asks for a transaction exported by another wallet, as a
+// file path or pasted. Watch-only wallets broadcast it, it must be signed
+// already. Seeded wallets sign their inputs first; BTC and LTC wallets then
+// broadcast it while DCR wallets save it for the watch-only wallet that
+// exported it.
+func (pg *Page) showImportTxModal() {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrTxFileOrData)).
+		SetPositiveButtonCallback(func(input string, tm *modal.TextInputModal) bool {
+			data, err := readTxData(input)
+			if err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+
+			if pg.selectedWallet.IsWatchingOnlyWallet() {
+				if err := pg.importTx(data, ""); err != nil {
+					tm.SetError(values.TranslateErr(err.Error()))
+					return false
+				}
+				return true
+			}
+
+			pg.showImportTxPasswordModal(data)
+			return true
+		})
+	textModal.Title(values.String(values.StrImportTransaction)).
+		SetPositiveButtonText(values.String(values.StrImport))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+func (pg *Page) showImportTxPasswordModal(data []byte) {
+	passwordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrImportTransaction)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := pg.importTx(data, password); err != nil {
+				pm.SetError(values.TranslateErr(err.Error()))
+				return false
+			}
+			return true
+		})
+	pg.ParentWindow().ShowModal(passwordModal)
+}
+
+// importTx signs the imported transaction with passphrase if the wallet holds
+// keys for it, then broadcasts it or, for seeded DCR wallets, saves the signed
+// transaction to the exports folder.
+func (pg *Page) importTx(data []byte, passphrase string) error {
+	msg := values.String(values.StrTxSent)
+	switch w := pg.selectedWallet.(type) {
+	case psbtWallet:
+		if _, err := w.BroadcastPSBT(data, passphrase, ""); err != nil {
+			return err
+		}
+	case externalSigningWallet:
+		if pg.selectedWallet.IsWatchingOnlyWallet() {
+			if _, err := w.BroadcastSignedTransaction(strings.TrimSpace(string(data)), ""); err != nil {
+				return err
+			}
+			break
+		}
+
+		signedTx, err := w.SignExternalTransaction(data, passphrase)
+		if err != nil {
+			return err
+		}
+		fileName, err := pg.exportFileName("signed", "txt")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(fileName, []byte(signedTx), libUtil.UserFilePerm); err != nil {
+			return err
+		}
+		msg = values.StringF(values.StrSignedTxExported, fileName)
+	default:
+		return fmt.Errorf("%s wallets can't import transactions", pg.selectedWallet.GetAssetType())
+	}
+
+	successModal := modal.NewSuccessModal(pg.Load, msg, modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(successModal)
+	return nil
+}
+
+// readTxData returns the content of the file at input if it's the path of
+// one, or input itself otherwise.
+func readTxData(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if info, err := os.Stat(input); err == nil && !info.IsDir() {
+		return os.ReadFile(input)
+	}
+	return []byte(input), nil
+}
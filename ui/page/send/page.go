@@ -9,7 +9,9 @@ import (
 	"gioui.org/widget"
 
 	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -58,6 +60,7 @@ type Page struct {
 	// retryExchange cryptomaterial.Button // TODO not included in design
 	nextButton      cryptomaterial.Button
 	closeButton     cryptomaterial.Button
+	importTxButton  cryptomaterial.Button
 	addRecipientBtn *cryptomaterial.Clickable
 
 	isFetchingExchangeRate bool
@@ -96,6 +99,7 @@ type authoredTxData struct {
 	totalCostUSD        string
 	balanceAfterSend    string
 	balanceAfterSendUSD string
+	addressReuseWarning string
 	sendAmount          string
 	sendAmountUSD       string
 }
@@ -141,9 +145,41 @@ func NewSendPage(l *load.Load, wallet sharedW.Asset) *Page {
 	pg.addRecipient()
 	pg.initLayoutWidgets()
 	pg.setAssetTypeForRecipients()
+	pg.updateNextButtonText()
 	return pg
 }
 
+// SetPaymentURI prefills the first recipient with the payment request. If the
+// wallet selector is displayed and the selected wallet is of another asset,
+// the first spending wallet of the requested asset is selected. Returns false
+// if there's no wallet the request can be paid from.
+func (pg *Page) SetPaymentURI(paymentURI *uri.PaymentURI) bool {
+	if pg.selectedWallet == nil || pg.selectedWallet.GetAssetType() != paymentURI.AssetType {
+		if pg.hideWalletDropdown {
+			return false
+		}
+
+		var wallet sharedW.Asset
+		for _, w := range pg.AssetsManager.AssetWallets(paymentURI.AssetType) {
+			if !w.IsWatchingOnlyWallet() {
+				wallet = w
+				break
+			}
+		}
+		if wallet == nil {
+			return false
+		}
+		pg.walletDropdown.SetSelectedWallet(wallet)
+		pg.walletChanged(wallet)
+	}
+
+	if len(pg.recipients) == 0 {
+		pg.addRecipient()
+	}
+	pg.recipients[0].setPaymentURI(paymentURI)
+	return true
+}
+
 func (pg *Page) addRecipient() {
 	if pg.selectedWallet == nil {
 		return
@@ -188,18 +224,20 @@ func (pg *Page) pageFields() pageFields {
 	}
 }
 
+func (pg *Page) walletChanged(w sharedW.Asset) {
+	pg.selectedWallet = w
+	pg.updateNextButtonText()
+	if pg.accountDropdown != nil {
+		pg.accountDropdown.Setup(w, pg.sourceAccount)
+		go pg.feeRateSelector.UpdatedFeeRate(pg.selectedWallet)
+		pg.setAssetTypeForRecipients()
+	}
+}
+
 // initWalletSelector is used for the send modal for wallet selection.
 func (pg *Page) initModalWalletSelector(wallet sharedW.Asset) {
 	pg.walletDropdown = components.NewWalletDropdown(pg.Load).
-		SetChangedCallback(func(w sharedW.Asset) {
-			pg.selectedWallet = w
-			if pg.accountDropdown != nil {
-				pg.accountDropdown.Setup(w, pg.sourceAccount)
-				go pg.feeRateSelector.UpdatedFeeRate(pg.selectedWallet)
-				pg.setAssetTypeForRecipients()
-			}
-
-		}).
+		SetChangedCallback(pg.walletChanged).
 		Setup(wallet)
 	if pg.selectedWallet == nil {
 		pg.selectedWallet = pg.walletDropdown.SelectedWallet()
@@ -215,7 +253,8 @@ func (pg *Page) initModalWalletSelector(wallet sharedW.Asset) {
 			if pg.selectedWallet == nil {
 				return false
 			}
-			accountIsValid := account.Number != load.MaxInt32 && !pg.selectedWallet.IsWatchingOnlyWallet()
+			accountIsValid := account.Number != load.MaxInt32 &&
+				(!pg.selectedWallet.IsWatchingOnlyWallet() || pg.exportsUnsignedTx())
 
 			if pg.selectedWallet.ReadBoolConfigValueForKey(sharedW.AccountMixerConfigSet, false) &&
 				!pg.selectedWallet.ReadBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, false) {
@@ -393,6 +432,13 @@ func (pg *Page) constructTx() {
 	pg.destinationAccount = pg.getDestinationAccounts()
 	pg.sourceAccount = sourceAccount
 
+	pg.addressReuseWarning = ""
+	if dcrAsset, ok := wal.(*dcr.Asset); ok {
+		if reused := dcrAsset.ReusedDestinationAddresses(); len(reused) > 0 {
+			pg.addressReuseWarning = values.StringF(values.StrAddressReuseWarning, strings.Join(reused, ", "))
+		}
+	}
+
 	if pg.exchangeRate != -1 && pg.usdExchangeSet {
 		pg.feeRateSelector.USDExchangeSet = true
 		pg.txFeeUSD = fmt.Sprintf("$%.4f", utils.CryptoToUSD(pg.exchangeRate, feeAndSize.Fee.CoinValue))
@@ -569,6 +615,7 @@ func (pg *Page) clearEstimates() {
 	pg.totalCostUSD = " - "
 	pg.balanceAfterSend = " - " + string(pg.selectedWallet.GetAssetType())
 	pg.balanceAfterSendUSD = " - "
+	pg.addressReuseWarning = ""
 	pg.sendAmount = " - "
 	pg.sendAmountUSD = " - "
 	pg.feeRateSelector.SetFeerate(0)
@@ -608,8 +655,16 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	if pg.importTxButton.Clicked(gtx) {
+		pg.showImportTxModal()
+	}
+
 	if pg.nextButton.Clicked(gtx) {
-		if pg.selectedWallet.IsUnsignedTxExist() {
+		if pg.exportsUnsignedTx() {
+			if pg.selectedWallet.IsUnsignedTxExist() {
+				pg.exportUnsignedTx()
+			}
+		} else if pg.selectedWallet.IsUnsignedTxExist() {
 			pg.confirmTxModal = newSendConfirmModal(pg.Load, pg.authoredTxData, pg.selectedWallet, func(txHash string) {
 				if pg.modalLayout == nil {
 					transaction, err := pg.selectedWallet.GetTransactionRaw(txHash)
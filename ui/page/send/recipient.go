@@ -8,7 +8,9 @@ import (
 	"gioui.org/widget"
 
 	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/addressbook"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -22,6 +24,7 @@ type recipient struct {
 
 	navigator   app.WindowNavigator
 	deleteBtn   *cryptomaterial.Clickable
+	contactsBtn *cryptomaterial.Clickable
 	description cryptomaterial.Editor
 
 	selectedWallet        sharedW.Asset
@@ -41,6 +44,7 @@ func newRecipient(l *load.Load, selectedWallet sharedW.Asset, pageParam getPageF
 		pageParam:      pageParam,
 		id:             id,
 		deleteBtn:      l.Theme.NewClickable(false),
+		contactsBtn:    l.Theme.NewClickable(false),
 	}
 
 	assetType := rp.selectedWallet.GetAssetType()
@@ -49,6 +53,8 @@ func newRecipient(l *load.Load, selectedWallet sharedW.Asset, pageParam getPageF
 	rp.amount.amountEditor.TextSize = values.TextSizeTransform(l.IsMobileView(), values.TextSize16)
 	rp.sendDestination = newSendDestination(l, assetType)
 
+	rp.sendDestination.paymentURIEntered = rp.setPaymentURI
+
 	rp.description = rp.Theme.Editor(new(widget.Editor), values.String(values.StrNote))
 	rp.description.Editor.SingleLine = false
 	rp.description.Editor.SetText("")
@@ -196,6 +202,27 @@ func (rp *recipient) setAmount(amount int64) {
 	rp.amount.setAmount(amount)
 }
 
+// setPaymentURI fills the recipient's fields with the payment request. The
+// note is only set if it's empty, to not overwrite what the user typed.
+func (rp *recipient) setPaymentURI(paymentURI *uri.PaymentURI) {
+	rp.sendDestination.accountSwitch.SetSelectedSegment(values.StrAddress)
+	rp.sendDestination.destinationAddressEditor.SetError("")
+	rp.sendDestination.destinationAddressEditor.Editor.SetText(paymentURI.Address)
+
+	if paymentURI.Amount > 0 {
+		rp.amount.SendMax = false
+		rp.setAmount(paymentURI.Amount)
+	}
+
+	if rp.description.Editor.Text() == "" {
+		note := paymentURI.Message
+		if note == "" {
+			note = paymentURI.Label
+		}
+		rp.description.Editor.SetText(note)
+	}
+}
+
 func (rp *recipient) amountValidationError(err string) {
 	rp.amount.setError(err)
 }
@@ -227,7 +254,7 @@ func (rp *recipient) recipientLayout(index int, showIcon bool) layout.Widget {
 			layout.Rigid(func(gtx C) D {
 				layoutBody := func(gtx C) D {
 					txt := fmt.Sprintf("%s %s", values.String(values.StrDestination), values.String(values.StrAddress))
-					return rp.contentWrapper(gtx, txt, rp.sendDestination.destinationAddressEditor.Layout)
+					return rp.contentWrapper(gtx, txt, rp.addressLayout)
 				}
 
 				if !rp.isShowSendToWallet() {
@@ -246,6 +273,19 @@ func (rp *recipient) recipientLayout(index int, showIcon bool) layout.Widget {
 	}
 }
 
+func (rp *recipient) addressLayout(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(rp.sendDestination.destinationAddressEditor.Layout),
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				lbl := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize14), values.String(values.StrAddressBook))
+				lbl.Color = rp.Theme.Color.Primary
+				return rp.contactsBtn.Layout(gtx, lbl.Layout)
+			})
+		}),
+	)
+}
+
 func (rp *recipient) topLayout(gtx C, index int) D {
 	txt := fmt.Sprintf("%s: %s %v", values.String(values.StrTo), values.String(values.StrRecipient), index)
 	titleTxt := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize16), txt)
@@ -383,6 +423,19 @@ func (rp *recipient) handle(gtx C) {
 		rp.amount.amountChanged()
 	}
 
+	if rp.contactsBtn.Clicked(gtx) {
+		address, err := rp.sendDestination.validateDestinationAddress()
+		if err != nil {
+			address = ""
+		}
+		contactPicker := newContactPickerModal(rp.Load, rp.amount.assetType, address, func(contact *addressbook.Contact) {
+			rp.sendDestination.destinationAddressEditor.SetError("")
+			rp.sendDestination.destinationAddressEditor.Editor.SetText(contact.Address)
+			rp.sendDestination.addressChanged()
+		})
+		rp.navigator.ShowModal(contactPicker)
+	}
+
 	if rp.deleteBtn.Clicked(gtx) {
 		title := values.String(values.StrRemoveRecipient)
 		msg := values.String(values.StrRemoveRecipientWarning)
@@ -7,6 +7,7 @@ import (
 	"gioui.org/widget"
 
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/uri"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -23,6 +24,7 @@ type destination struct {
 	*load.Load
 
 	addressChanged           func()
+	paymentURIEntered        func(*uri.PaymentURI)
 	destinationAddressEditor cryptomaterial.Editor
 	sourceAccount            *sharedW.Account
 
@@ -189,12 +191,38 @@ func (dst *destination) handle(gtx C) {
 		if gtx.Source.Focused(dst.destinationAddressEditor.Editor) {
 			switch event.(type) {
 			case widget.ChangeEvent:
+				dst.handlePaymentURI()
 				dst.addressChanged()
 			}
 		}
 	}
 }
 
+// handlePaymentURI passes a payment URI entered in the address editor on to
+// paymentURIEntered, which replaces it with the address it pays to.
+func (dst *destination) handlePaymentURI() {
+	text := dst.destinationAddressEditor.Editor.Text()
+	if !uri.IsPaymentURI(text) {
+		return
+	}
+
+	paymentURI, err := uri.Parse(text)
+	if err != nil {
+		dst.destinationAddressEditor.SetError(err.Error())
+		return
+	}
+
+	wallet := dst.walletDropdown.SelectedWallet()
+	if wallet != nil && wallet.GetAssetType() != paymentURI.AssetType {
+		dst.destinationAddressEditor.SetError(values.StringF(values.StrPaymentURIWrongAsset, paymentURI.AssetType))
+		return
+	}
+
+	if dst.paymentURIEntered != nil {
+		dst.paymentURIEntered(paymentURI)
+	}
+}
+
 // styleWidgets sets the appropriate colors for the destination widgets.
 func (dst *destination) styleWidgets() {
 	// dst.accountSwitch.Active, dst.accountSwitch.Inactive = dst.Theme.Color.Surface, color.NRGBA{}
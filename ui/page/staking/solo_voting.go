@@ -0,0 +1,176 @@
+package staking
+
+import (
+	"os"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// soloVotingSection shows whether the wallet's tickets are voted by a VSP or
+// by the wallet itself. Solo voting wallets must stay online to vote, which
+// is made clear here.
+func (pg *Page) soloVotingSection(gtx C) D {
+	node := pg.dcrWallet.SoloVotingNode()
+	return pg.pageSections(gtx, func(gtx C) D {
+		textSize14 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize14)
+		textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						mode := values.String(values.StrVsp)
+						if node != nil {
+							mode = values.String(values.StrSoloVoting)
+						}
+						title := pg.Theme.Label(textSize16, values.String(values.StrVotingMode)+": "+mode)
+						title.Font.Weight = font.SemiBold
+						return title.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						info := values.String(values.StrVSPVotingInfo)
+						color := pg.Theme.Color.GrayText2
+						if node != nil {
+							info = values.StringF(values.StrSoloVotingInfo, node.Host)
+							color = pg.Theme.Color.Danger
+						}
+						lbl := pg.Theme.Label(textSize14, info)
+						lbl.Color = color
+						return lbl.Layout(gtx)
+					}),
+				)
+			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.dcrWallet.IsWatchingOnlyWallet() {
+					return D{}
+				}
+				pg.soloVotingBtn.Text = values.String(values.StrSetUpSoloVoting)
+				if node != nil {
+					pg.soloVotingBtn.Text = values.String(values.StrUseVSP)
+				}
+				return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, pg.soloVotingBtn.Layout)
+			}),
+		)
+	})
+}
+
+func (pg *Page) handleSoloVotingBtn() {
+	if pg.dcrWallet.IsAutoTicketsPurchaseActive() {
+		errModal := modal.NewErrorModal(pg.Load, values.String(values.StrAutoTicketWarn), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	if !pg.dcrWallet.IsSoloVotingEnabled() {
+		pg.showSoloVotingModal()
+		return
+	}
+
+	// The tickets bought without a VSP miss their votes once the wallet
+	// stops voting them, make sure that's intended.
+	soloTickets, err := pg.dcrWallet.UnspentSoloTickets()
+	if err != nil {
+		errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+	if soloTickets == 0 {
+		pg.disableSoloVoting()
+		return
+	}
+
+	confirmModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrUseVSP)).
+		Body(values.StringF(values.StrDisableSoloVotingWarning, soloTickets)).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrUseVSP)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			pg.disableSoloVoting()
+			return true
+		}).
+		PositiveButtonStyle(pg.Theme.Color.Surface, pg.Theme.Color.Danger)
+	pg.ParentWindow().ShowModal(confirmModal)
+}
+
+func (pg *Page) disableSoloVoting() {
+	if err := pg.dcrWallet.SetSoloVotingNode(nil, ""); err != nil {
+		errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+	}
+}
+
+// showSoloVotingModal asks for the dcrd node to sync with in solo voting mode.
+func (pg *Page) showSoloVotingModal() {
+	hostEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrDcrdRPCAddress))
+	hostEditor.Editor.SingleLine, hostEditor.IsRequired = true, true
+	userEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrRPCUser))
+	userEditor.Editor.SingleLine = true
+	passEditor := pg.Theme.EditorPassword(new(widget.Editor), values.String(values.StrRPCPassword))
+	passEditor.Editor.SingleLine = true
+	certEditor := pg.Theme.Editor(new(widget.Editor), values.String(values.StrRPCCertPath))
+	certEditor.Editor.SingleLine = true
+	// The RPC password is saved encrypted with the spending passphrase.
+	walletPassEditor := pg.Theme.EditorPassword(new(widget.Editor), values.String(values.StrSpendingPassword))
+	walletPassEditor.Editor.SingleLine = true
+
+	soloVotingModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrSoloVoting)).
+		UseCustomWidget(func(gtx C) D {
+			editor := func(e layout.Widget) layout.FlexChild {
+				return layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, e)
+				})
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pg.Theme.Body1(values.String(values.StrSoloVotingSetupMsg)).Layout),
+				editor(hostEditor.Layout),
+				editor(userEditor.Layout),
+				editor(passEditor.Layout),
+				editor(certEditor.Layout),
+				editor(walletPassEditor.Layout),
+			)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrSave)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			hostEditor.SetError("")
+			certEditor.SetError("")
+			walletPassEditor.SetError("")
+
+			node := &dcr.SoloVotingNode{
+				Host: hostEditor.Editor.Text(),
+				User: userEditor.Editor.Text(),
+				Pass: passEditor.Editor.Text(),
+			}
+			if certPath := certEditor.Editor.Text(); certPath != "" {
+				cert, err := os.ReadFile(certPath)
+				if err != nil {
+					certEditor.SetError(err.Error())
+					return false
+				}
+				node.CACert = string(cert)
+			}
+
+			if node.Pass != "" && walletPassEditor.Editor.Text() == "" {
+				walletPassEditor.SetError(values.String(values.StrSoloVotingPassRequired))
+				return false
+			}
+
+			if err := pg.dcrWallet.SetSoloVotingNode(node, walletPassEditor.Editor.Text()); err != nil {
+				if err.Error() == libutils.ErrInvalidPassphrase {
+					walletPassEditor.SetError(values.TranslateErr(err.Error()))
+				} else {
+					hostEditor.SetError(values.TranslateErr(err.Error()))
+				}
+				return false
+			}
+			return true
+		})
+	pg.ParentWindow().ShowModal(soloVotingModal)
+}
@@ -14,6 +14,7 @@ import (
 
 func (pg *Page) initStakePriceWidget() *Page {
 	pg.stakeSettings = pg.Theme.NewClickable(false)
+	pg.ticketBuyerHistory = pg.Theme.NewClickable(false)
 	_, pg.infoButton = components.SubpageHeaderButtons(pg.Load)
 
 	pg.stake = pg.Theme.Switch()
@@ -45,6 +46,13 @@ func (pg *Page) pageHead(gtx C) D {
 							Left:  values.MarginPadding8,
 						}.Layout(gtx, pg.stake.Layout)
 					}),
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+							return pg.ticketBuyerHistory.Layout(gtx, func(gtx C) D {
+								return pg.Theme.Icons.TimerIcon.LayoutTransform(gtx, isMobile, values.MarginPadding24)
+							})
+						})
+					}),
 					layout.Rigid(func(gtx C) D {
 						icon := pg.Theme.Icons.SettingsIcon
 						return pg.stakeSettings.Layout(gtx, func(gtx C) D {
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"context"
+	"math"
 	"strconv"
 
 	"gioui.org/font"
@@ -31,10 +32,29 @@ type ticketBuyerModal struct {
 	accountDropdown     *components.AccountDropdown
 
 	vspSelector *components.VSPSelector
+	// backupVSPs are tried in order when the selected VSP can't be used.
+	backupVSPs      []*backupVSP
+	addBackupVSPBtn *cryptomaterial.Clickable
+	maxFeeEditor    cryptomaterial.Editor
+
+	maxTicketPriceEditor cryptomaterial.Editor
+	maxPerDayEditor      cryptomaterial.Editor
+	maxPerWindowEditor   cryptomaterial.Editor
+	activeFromEditor     cryptomaterial.Editor
+	activeToEditor       cryptomaterial.Editor
+
+	// soloVoting is true if tickets are bought without a VSP, in which case
+	// no VSP needs to be selected.
+	soloVoting bool
 
 	dcrImpl *dcr.Asset
 }
 
+type backupVSP struct {
+	selector  *components.VSPSelector
+	removeBtn *cryptomaterial.Clickable
+}
+
 func newTicketBuyerModal(l *load.Load, wallet *dcr.Asset) *ticketBuyerModal {
 	tb := &ticketBuyerModal{
 		Load:  l,
@@ -43,12 +63,26 @@ func newTicketBuyerModal(l *load.Load, wallet *dcr.Asset) *ticketBuyerModal {
 		cancel:          l.Theme.OutlineButton(values.String(values.StrCancel)),
 		saveSettingsBtn: l.Theme.Button(values.String(values.StrSave)),
 		vspSelector:     components.NewVSPSelector(l, wallet).Title(values.String(values.StrSelectVSP)),
+		addBackupVSPBtn: l.Theme.NewClickable(false),
+		soloVoting:      wallet.IsSoloVotingEnabled(),
 		dcrImpl:         wallet,
 	}
 
 	tb.balToMaintainEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrBalToMaintain))
 	tb.balToMaintainEditor.Editor.SingleLine = true
 
+	tb.maxFeeEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrMaxVSPFee))
+	tb.maxFeeEditor.Editor.SingleLine = true
+
+	tb.maxTicketPriceEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrMaxTicketPrice))
+	tb.maxPerDayEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrMaxTicketsPerDay))
+	tb.maxPerWindowEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrMaxTicketsPerWindow))
+	tb.activeFromEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrActiveFromHour))
+	tb.activeToEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrActiveToHour))
+	for _, editor := range tb.limitEditors() {
+		editor.Editor.SingleLine = true
+	}
+
 	tb.saveSettingsBtn.SetEnabled(false)
 
 	return tb
@@ -96,9 +130,33 @@ func (tb *ticketBuyerModal) OnResume() {
 
 		_ = tb.accountDropdown.Setup(tb.dcrImpl, account)
 
-		tb.vspSelector.SelectVSP(tbConfig.VspHost)
+		tb.backupVSPs = nil
+		if len(tbConfig.VspHosts) > 0 {
+			tb.vspSelector.SelectVSP(tbConfig.VspHosts[0])
+			for _, host := range tbConfig.VspHosts[1:] {
+				tb.addBackupVSP().selector.SelectVSP(host)
+			}
+		}
+		if tbConfig.MaxFeePercent > 0 {
+			tb.maxFeeEditor.Editor.SetText(strconv.FormatFloat(tbConfig.MaxFeePercent, 'f', -1, 64))
+		}
 		w := tb.dcrImpl
 		tb.balToMaintainEditor.Editor.SetText(strconv.FormatFloat(w.ToAmount(tbConfig.BalanceToMaintain).ToCoin(), 'f', 0, 64))
+
+		limits := tbConfig.TicketBuyerLimits
+		if limits.MaxTicketPrice > 0 {
+			tb.maxTicketPriceEditor.Editor.SetText(strconv.FormatFloat(w.ToAmount(limits.MaxTicketPrice).ToCoin(), 'f', -1, 64))
+		}
+		if limits.MaxTicketsPerDay > 0 {
+			tb.maxPerDayEditor.Editor.SetText(strconv.Itoa(int(limits.MaxTicketsPerDay)))
+		}
+		if limits.MaxTicketsPerWindow > 0 {
+			tb.maxPerWindowEditor.Editor.SetText(strconv.Itoa(int(limits.MaxTicketsPerWindow)))
+		}
+		if limits.ActiveFromHour != limits.ActiveToHour {
+			tb.activeFromEditor.Editor.SetText(strconv.Itoa(int(limits.ActiveFromHour)))
+			tb.activeToEditor.Editor.SetText(strconv.Itoa(int(limits.ActiveToHour)))
+		}
 	}
 
 	if tb.accountDropdown.SelectedAccount() == nil {
@@ -129,10 +187,37 @@ func (tb *ticketBuyerModal) Layout(gtx C) D {
 					return tb.balToMaintainEditor.Layout(gtx)
 				}),
 				layout.Rigid(func(gtx C) D {
+					if !tb.soloVoting {
+						return D{}
+					}
+					return components.VerticalInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+						lbl := tb.Theme.Label(values.TextSizeTransform(tb.IsMobileView(), values.TextSize14), values.String(values.StrSoloTicketBuyerInfo))
+						lbl.Color = tb.Theme.Color.GrayText2
+						return lbl.Layout(gtx)
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					if tb.soloVoting {
+						return D{}
+					}
 					return components.VerticalInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
 						return tb.vspSelector.Layout(tb.ParentWindow(), gtx)
 					})
 				}),
+				layout.Rigid(func(gtx C) D {
+					if tb.soloVoting {
+						return D{}
+					}
+					return tb.backupVSPsLayout(gtx)
+				}),
+				layout.Rigid(func(gtx C) D {
+					if tb.soloVoting {
+						return D{}
+					}
+					tb.maxFeeEditor.TextSize = values.TextSizeTransform(tb.IsMobileView(), values.TextSize14)
+					return tb.maxFeeEditor.Layout(gtx)
+				}),
+				layout.Rigid(tb.limitsLayout),
 			)
 		},
 		func(gtx C) D {
@@ -154,8 +239,140 @@ func (tb *ticketBuyerModal) Layout(gtx C) D {
 	return tb.Modal.Layout(gtx, l)
 }
 
+func (tb *ticketBuyerModal) limitEditors() []*cryptomaterial.Editor {
+	return []*cryptomaterial.Editor{
+		&tb.maxTicketPriceEditor, &tb.maxPerDayEditor, &tb.maxPerWindowEditor,
+		&tb.activeFromEditor, &tb.activeToEditor,
+	}
+}
+
+func (tb *ticketBuyerModal) limitsLayout(gtx C) D {
+	textSize14 := values.TextSizeTransform(tb.IsMobileView(), values.TextSize14)
+	for _, editor := range tb.limitEditors() {
+		editor.TextSize = textSize14
+	}
+
+	editorPair := func(left, right *cryptomaterial.Editor) layout.FlexChild {
+		return layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{}.Layout(gtx,
+					layout.Flexed(.5, func(gtx C) D {
+						return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, left.Layout)
+					}),
+					layout.Flexed(.5, func(gtx C) D {
+						return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, right.Layout)
+					}),
+				)
+			})
+		})
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+				lbl := tb.Theme.Label(textSize14, values.String(values.StrTicketBuyerLimits))
+				lbl.Font.Weight = font.SemiBold
+				return lbl.Layout(gtx)
+			})
+		}),
+		layout.Rigid(tb.maxTicketPriceEditor.Layout),
+		editorPair(&tb.maxPerDayEditor, &tb.maxPerWindowEditor),
+		editorPair(&tb.activeFromEditor, &tb.activeToEditor),
+	)
+}
+
+// limits parses the limit editors, empty editors meaning no limit. ok is false
+// if an editor has an invalid value, which is flagged on the editor.
+func (tb *ticketBuyerModal) limits() (limits dcr.TicketBuyerLimits, ok bool) {
+	parseCount := func(editor *cryptomaterial.Editor, maxValue int) (int32, bool) {
+		text := editor.Editor.Text()
+		if text == "" {
+			return 0, true
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 0 || n > maxValue {
+			editor.SetError(values.String(values.StrInvalidAmount))
+			return 0, false
+		}
+		return int32(n), true
+	}
+
+	if text := tb.maxTicketPriceEditor.Editor.Text(); text != "" {
+		price, err := strconv.ParseFloat(text, 64)
+		if err != nil || price < 0 {
+			tb.maxTicketPriceEditor.SetError(values.String(values.StrInvalidAmount))
+			return limits, false
+		}
+		limits.MaxTicketPrice = dcr.AmountAtom(price)
+	}
+
+	var okDay, okWindow, okFrom, okTo bool
+	limits.MaxTicketsPerDay, okDay = parseCount(&tb.maxPerDayEditor, math.MaxInt32)
+	limits.MaxTicketsPerWindow, okWindow = parseCount(&tb.maxPerWindowEditor, math.MaxInt32)
+	limits.ActiveFromHour, okFrom = parseCount(&tb.activeFromEditor, 23)
+	limits.ActiveToHour, okTo = parseCount(&tb.activeToEditor, 23)
+	return limits, okDay && okWindow && okFrom && okTo
+}
+
+func (tb *ticketBuyerModal) backupVSPsLayout(gtx C) D {
+	textSize14 := values.TextSizeTransform(tb.IsMobileView(), values.TextSize14)
+	rows := make([]layout.FlexChild, 0, len(tb.backupVSPs)+1)
+	for _, backup := range tb.backupVSPs {
+		backup := backup
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					layout.Flexed(1, func(gtx C) D {
+						return backup.selector.Layout(tb.ParentWindow(), gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+							return backup.removeBtn.Layout(gtx, tb.Theme.NewIcon(tb.Theme.Icons.DeleteIcon).Layout20dp)
+						})
+					}),
+				)
+			})
+		}))
+	}
+	rows = append(rows, layout.Rigid(func(gtx C) D {
+		return layout.Inset{Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+			return tb.addBackupVSPBtn.Layout(gtx, func(gtx C) D {
+				lbl := tb.Theme.Label(textSize14, values.String(values.StrAddBackupVSP))
+				lbl.Color = tb.Theme.Color.Primary
+				return lbl.Layout(gtx)
+			})
+		})
+	}))
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+func (tb *ticketBuyerModal) addBackupVSP() *backupVSP {
+	backup := &backupVSP{
+		selector:  components.NewVSPSelector(tb.Load, tb.dcrImpl).Title(values.String(values.StrAddBackupVSP)),
+		removeBtn: tb.Theme.NewClickable(false),
+	}
+	tb.backupVSPs = append(tb.backupVSPs, backup)
+	return backup
+}
+
+// vspHosts returns the selected VSP followed by the selected backup VSPs,
+// without duplicates.
+func (tb *ticketBuyerModal) vspHosts() []string {
+	hosts := []string{tb.vspSelector.SelectedVSP().Host}
+	seen := map[string]bool{hosts[0]: true}
+	for _, backup := range tb.backupVSPs {
+		vsp := backup.selector.SelectedVSP()
+		if vsp == nil || seen[vsp.Host] {
+			continue
+		}
+		seen[vsp.Host] = true
+		hosts = append(hosts, vsp.Host)
+	}
+	return hosts
+}
+
 func (tb *ticketBuyerModal) canSave() bool {
-	if tb.vspSelector.SelectedVSP() == nil {
+	if !tb.soloVoting && tb.vspSelector.SelectedVSP() == nil {
 		return false
 	}
 
@@ -197,18 +414,62 @@ func (tb *ticketBuyerModal) Handle(gtx C) {
 		tb.Dismiss()
 	}
 
+	if tb.addBackupVSPBtn.Clicked(gtx) {
+		tb.addBackupVSP()
+	}
+
+	for i, backup := range tb.backupVSPs {
+		if backup.removeBtn.Clicked(gtx) {
+			tb.backupVSPs = append(tb.backupVSPs[:i], tb.backupVSPs[i+1:]...)
+			break
+		}
+	}
+
+	if tb.maxFeeEditor.Changed() {
+		tb.maxFeeEditor.SetError("")
+	}
+
+	for _, editor := range tb.limitEditors() {
+		if editor.Changed() {
+			editor.SetError("")
+		}
+	}
+
 	if tb.saveSettingsBtn.Clicked(gtx) {
-		vspHost := tb.vspSelector.SelectedVSP().Host
 		amount, err := strconv.ParseFloat(tb.balToMaintainEditor.Editor.Text(), 64)
 		if err != nil {
 			tb.SetError(err.Error())
 			return
 		}
 
+		var maxFee float64
+		if maxFeeText := tb.maxFeeEditor.Editor.Text(); maxFeeText != "" {
+			maxFee, err = strconv.ParseFloat(maxFeeText, 64)
+			if err != nil || maxFee < 0 {
+				tb.maxFeeEditor.SetError(values.String(values.StrInvalidAmount))
+				return
+			}
+		}
+
+		limits, ok := tb.limits()
+		if !ok {
+			return
+		}
+
 		balToMaintain := dcr.AmountAtom(amount)
 		account := tb.accountDropdown.SelectedAccount()
 
-		tb.dcrImpl.SetAutoTicketsBuyerConfig(vspHost, account.Number, balToMaintain)
+		// Solo voting doesn't use the VSPs, keep them for when it's disabled.
+		vspHosts := tb.dcrImpl.AutoTicketsBuyerConfig().VspHosts
+		if !tb.soloVoting {
+			vspHosts = tb.vspHosts()
+		}
+
+		tb.dcrImpl.SetAutoTicketsBuyerConfig(vspHosts, maxFee, account.Number, balToMaintain)
+		if err := tb.dcrImpl.SetAutoTicketsBuyerLimits(limits); err != nil {
+			tb.SetError(err.Error())
+			return
+		}
 		tb.settingsSaved()
 		tb.Dismiss()
 	}
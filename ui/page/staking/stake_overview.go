@@ -3,6 +3,7 @@ package staking
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"gioui.org/layout"
@@ -52,11 +53,12 @@ type Page struct {
 
 	ticketOverview *dcr.StakingOverview
 
-	ticketsList    *cryptomaterial.ClickableList
-	stakeSettings  *cryptomaterial.Clickable
-	stake          *cryptomaterial.Switch
-	infoButton     cryptomaterial.IconButton
-	materialLoader material.LoaderStyle
+	ticketsList        *cryptomaterial.ClickableList
+	stakeSettings      *cryptomaterial.Clickable
+	ticketBuyerHistory *cryptomaterial.Clickable
+	stake              *cryptomaterial.Switch
+	infoButton         cryptomaterial.IconButton
+	materialLoader     material.LoaderStyle
 
 	ticketPrice        string
 	totalRewards       string
@@ -65,6 +67,13 @@ type Page struct {
 	navToSettingsBtn cryptomaterial.Button
 	processingTicket uint32
 
+	vspFeeReport  *dcr.VSPFeeReconcileReport
+	payVSPFeesBtn cryptomaterial.Button
+	soloVotingBtn cryptomaterial.Button
+
+	stakingReturns []*dcr.TicketReturn
+	stakingPeriod  *widget.Enum
+
 	dcrWallet *dcr.Asset
 
 	// ticketContext is a managed context instance that is shut once a shutdown
@@ -83,7 +92,8 @@ func NewStakingPage(l *load.Load, dcrWallet *dcr.Asset) *Page {
 				Alignment: layout.Middle,
 			},
 		},
-		dcrWallet: dcrWallet,
+		dcrWallet:     dcrWallet,
+		stakingPeriod: &widget.Enum{Value: string(dcr.StakingPeriodMonth)},
 	}
 
 	// context will list for a shutdown request.
@@ -96,6 +106,8 @@ func NewStakingPage(l *load.Load, dcrWallet *dcr.Asset) *Page {
 	pg.initTicketList()
 
 	pg.navToSettingsBtn = l.Theme.Button(values.StringF(values.StrEnableAPI, values.String(values.StrVsp)))
+	pg.payVSPFeesBtn = l.Theme.Button(values.String(values.StrPayVSPFees))
+	pg.soloVotingBtn = l.Theme.OutlineButton(values.String(values.StrSetUpSoloVoting))
 
 	return pg
 }
@@ -118,7 +130,8 @@ func (pg *Page) OnNavigatedTo() {
 
 		pg.setStakingButtonsState()
 
-		pg.listenForTxNotifications() // tx ntfn listener is stopped in OnNavigatedFrom().
+		pg.listenForTxNotifications()  // tx ntfn listener is stopped in OnNavigatedFrom().
+		pg.listenForVSPFeeReconciler() // stopped in OnNavigatedFrom().
 
 		go func() {
 			pg.showMaterialLoader = true
@@ -170,9 +183,24 @@ func (pg *Page) loadPageData() {
 		}
 
 		pg.ParentWindow().Reload()
+
+		// Staking returns look up every spent ticket and possibly its
+		// historical rate, don't hold the overview back for them.
+		go pg.loadStakingReturns()
 	}()
 }
 
+func (pg *Page) loadStakingReturns() {
+	stakingReturns, err := pg.dcrWallet.StakingReturns(pg.historicalDCRRate())
+	if err != nil {
+		log.Errorf("Error reading staking returns: %v", err)
+		return
+	}
+
+	pg.stakingReturns = stakingReturns
+	pg.ParentWindow().Reload()
+}
+
 func (pg *Page) isTicketsPurchaseAllowed() bool {
 	return pg.AssetsManager.IsHTTPAPIPrivacyModeOff(libutils.VspAPI)
 }
@@ -199,7 +227,10 @@ func (pg *Page) Layout(gtx C) D {
 		return pg.Theme.List(pg.scrollContainer).Layout(gtx, 1, func(gtx C, _ int) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(pg.stakePriceSection),
+				layout.Rigid(pg.soloVotingSection),
+				layout.Rigid(pg.vspFeesSection),
 				layout.Rigid(pg.stakeStatisticsSection),
+				layout.Rigid(pg.stakingRewardsSection),
 				layout.Rigid(pg.ticketListLayout),
 			)
 		})
@@ -227,6 +258,14 @@ func (pg *Page) pageSections(gtx C, body layout.Widget) D {
 func (pg *Page) HandleUserInteractions(gtx C) {
 	pg.setStakingButtonsState()
 
+	if pg.payVSPFeesBtn.Clicked(gtx) {
+		pg.showVSPFeesPasswordModal()
+	}
+
+	if pg.soloVotingBtn.Clicked(gtx) {
+		pg.handleSoloVotingBtn()
+	}
+
 	if pg.navToSettingsBtn.Clicked(gtx) {
 		pg.ParentWindow().Display(settings.NewAppSettingsPage(pg.Load))
 	}
@@ -254,6 +293,10 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	if pg.ticketBuyerHistory.Clicked(gtx) {
+		pg.showTicketBuyerHistoryModal()
+	}
+
 	if pg.stakeSettings.Clicked(gtx) && !pg.dcrWallet.IsWatchingOnlyWallet() {
 		if pg.dcrWallet.IsAutoTicketsPurchaseActive() {
 			errModal := modal.NewErrorModal(pg.Load, values.String(values.StrAutoTicketWarn), modal.DefaultClickFunc())
@@ -358,6 +401,10 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 		return
 	}
 
+	// Resuming needs the startup password to keep the wallet passphrase safe.
+	canResume := pg.AssetsManager.IsStartupSecuritySet() && pg.AssetsManager.StartupSecurityType() == sharedW.PassphraseTypePass
+	resumeOnUnlock := &widget.Bool{Value: pg.dcrWallet.IsTicketBuyerResumeEnabled()}
+
 	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
 		EnableName(false).
 		EnableConfirmPassword(false).
@@ -368,7 +415,10 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrWalletToPurchaseFrom, pg.dcrWallet.GetWalletName())).Layout),
 				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrSelectedAccount, name)).Layout),
 				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrBalToMaintainValue, balToMaintain)).Layout), layout.Rigid(func(gtx C) D {
-					label := pg.Theme.Label(values.TextSize14, fmt.Sprintf("VSP: %s", tbConfig.VspHost))
+					label := pg.Theme.Label(values.TextSize14, fmt.Sprintf("VSP: %s", strings.Join(tbConfig.VspHosts, ", ")))
+					if pg.dcrWallet.IsSoloVotingEnabled() {
+						label.Text = values.String(values.StrSoloTicketBuyerInfo)
+					}
 					return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, label.Layout)
 				}),
 				layout.Rigid(func(gtx C) D {
@@ -396,6 +446,25 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 						})
 					})
 				}),
+				layout.Rigid(func(gtx C) D {
+					if !canResume {
+						return D{}
+					}
+					checkBox := pg.Theme.CheckBox(resumeOnUnlock, values.String(values.StrResumeTicketBuyer))
+					return layout.Inset{Top: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
+						return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+							layout.Rigid(checkBox.Layout),
+							layout.Rigid(func(gtx C) D {
+								if !resumeOnUnlock.Value {
+									return D{}
+								}
+								warning := pg.Theme.Label(values.TextSize14, values.String(values.StrResumeTicketBuyerWarning))
+								warning.Color = pg.Theme.Color.GrayText2
+								return warning.Layout(gtx)
+							}),
+						)
+					})
+				}),
 			)
 		}).
 		SetNegativeButtonCallback(func() {
@@ -420,6 +489,9 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 			pg.stake.SetChecked(pg.dcrWallet.IsAutoTicketsPurchaseActive())
 			pg.ParentWindow().Reload()
 			pm.Dismiss()
+			if canResume {
+				pg.updateTicketBuyerResume(password, resumeOnUnlock.Value)
+			}
 
 			return true
 		})
@@ -435,4 +507,5 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 // Part of the load.Page interface.
 func (pg *Page) OnNavigatedFrom() {
 	pg.stopTxNotificationsListener()
+	pg.stopVSPFeeReconcilerListener()
 }
@@ -2,14 +2,25 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
 	"gioui.org/font"
 	"gioui.org/layout"
+	"gioui.org/unit"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
+	"github.com/decred/dcrd/dcrutil/v4"
 )
 
+// maxRewardsBuckets is the number of most recent periods shown in the staking
+// rewards section.
+const maxRewardsBuckets = 12
+
 type statisticsItem struct {
 	Icon        *cryptomaterial.Image
 	Title       string
@@ -111,3 +122,129 @@ func (pg *Page) dataStatisticsItem(gtx C, item *statisticsItem) D {
 		}),
 	)
 }
+
+// historicalDCRRate returns a function that looks up the USD rate of DCR at a
+// given time, or nil if there's no rate source.
+func (pg *Page) historicalDCRRate() func(time.Time) (float64, error) {
+	rateSource := pg.AssetsManager.RateSource
+	if rateSource == nil {
+		return nil
+	}
+	return ext.HistoricalRateLookup(rateSource, values.AssetExchangeMarketValue[libutils.DCRWalletAsset])
+}
+
+// stakingRewardsSection shows the realized return of the wallet's voted and
+// revoked tickets and their rewards and fees per day, week or month.
+func (pg *Page) stakingRewardsSection(gtx C) D {
+	isMobile := pg.IsMobileView()
+	textSize14 := values.TextSizeTransform(isMobile, values.TextSize14)
+	textSize16 := values.TextSizeTransform(isMobile, values.TextSize16)
+	returns := pg.stakingReturns
+
+	return pg.pageSections(gtx, func(gtx C) D {
+		children := []layout.FlexChild{
+			layout.Rigid(func(gtx C) D {
+				txt := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize20), values.String(values.StrStakingRewards))
+				txt.Font.Weight = font.SemiBold
+				return layout.Inset{
+					Bottom: values.MarginPaddingTransform(isMobile, values.MarginPadding16),
+				}.Layout(gtx, txt.Layout)
+			}),
+		}
+
+		if len(returns) == 0 {
+			children = append(children, layout.Rigid(func(gtx C) D {
+				lbl := pg.Theme.Label(textSize14, values.String(values.StrNoStakingRewards))
+				lbl.Color = pg.Theme.Color.GrayText2
+				return lbl.Layout(gtx)
+			}))
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}
+
+		var totalTicketReturn float64
+		for _, tr := range returns {
+			totalTicketReturn += tr.AnnualizedReturn()
+		}
+		walletReturn := fmt.Sprintf("%.2f%%", dcr.AnnualizedStakingReturn(returns)*100)
+		avgTicketReturn := fmt.Sprintf("%.2f%%", totalTicketReturn/float64(len(returns))*100)
+
+		children = append(children,
+			layout.Rigid(func(gtx C) D {
+				return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						return pg.dataRows(gtx, values.String(values.StrAnnualizedReturn), walletReturn, layout.Horizontal, layout.Middle)
+					}),
+					layout.Rigid(func(gtx C) D {
+						return pg.dataRows(gtx, values.String(values.StrAvgTicketReturn), avgTicketReturn, layout.Horizontal, layout.Middle)
+					}),
+				)
+			}),
+			layout.Rigid(func(gtx C) D {
+				return components.VerticalInset(values.MarginPadding8).Layout(gtx, func(gtx C) D {
+					radio := func(period dcr.StakingPeriod, label string) layout.FlexChild {
+						return layout.Rigid(pg.Theme.RadioButton(pg.stakingPeriod, string(period), values.String(label), pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary).Layout)
+					}
+					return layout.Flex{}.Layout(gtx,
+						radio(dcr.StakingPeriodDay, values.StrDaily),
+						radio(dcr.StakingPeriodWeek, values.StrWeekly),
+						radio(dcr.StakingPeriodMonth, values.StrMonthly),
+					)
+				})
+			}),
+		)
+
+		period := dcr.StakingPeriod(pg.stakingPeriod.Value)
+		buckets := dcr.BucketStakingReturns(returns, period)
+		if len(buckets) > maxRewardsBuckets {
+			buckets = buckets[:maxRewardsBuckets]
+		}
+		for _, bucket := range buckets {
+			bucket := bucket
+			children = append(children, layout.Rigid(func(gtx C) D {
+				return components.VerticalInset(values.MarginPadding8).Layout(gtx, func(gtx C) D {
+					return pg.rewardsBucketRow(gtx, bucket, period, textSize14, textSize16)
+				})
+			}))
+		}
+
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+}
+
+func (pg *Page) rewardsBucketRow(gtx C, bucket *dcr.StakingRewardsBucket, period dcr.StakingPeriod, textSize14, textSize16 unit.Sp) D {
+	dateFormat := "Jan 2, 2006"
+	if period == dcr.StakingPeriodMonth {
+		dateFormat = "January 2006"
+	}
+
+	grayLabel := func(txt string) layout.Widget {
+		lbl := pg.Theme.Label(textSize14, txt)
+		lbl.Color = pg.Theme.Color.GrayText2
+		return lbl.Layout
+	}
+
+	return layout.Flex{Spacing: layout.SpaceBetween}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pg.Theme.Label(textSize16, bucket.Start.Format(dateFormat)).Layout),
+				layout.Rigid(grayLabel(values.StringF(values.StrVotedRevokedCount, bucket.Votes, bucket.Revocations))),
+			)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					netRewards := dcrutil.Amount(bucket.NetRewards()).String()
+					if bucket.FiatValue != nil {
+						netRewards += " (" + utils.FormatAsUSDString(pg.Printer, *bucket.FiatValue) + ")"
+					}
+					lbl := pg.Theme.Label(textSize16, netRewards)
+					lbl.Font.Weight = font.SemiBold
+					return lbl.Layout(gtx)
+				}),
+				layout.Rigid(grayLabel(fmt.Sprintf("%s: %s, %s: %s",
+					values.String(values.StrTicketFees), dcrutil.Amount(bucket.TicketFees),
+					values.String(values.StrVSPFees), dcrutil.Amount(bucket.VSPFees)))),
+			)
+		}),
+	)
+}
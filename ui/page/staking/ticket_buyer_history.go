@@ -0,0 +1,78 @@
+package staking
+
+import (
+	"gioui.org/font"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/wallet/walletdata"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// ticketBuyerHistoryLimit is the number of ticket buyer runs listed in the
+// history modal.
+const ticketBuyerHistoryLimit = 50
+
+// showTicketBuyerHistoryModal lists the last runs of the ticket buyer with
+// the reasons they started and stopped for.
+func (pg *Page) showTicketBuyerHistoryModal() {
+	sessions, err := pg.dcrWallet.TicketBuyerSessions(ticketBuyerHistoryLimit)
+	if err != nil {
+		errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	historyModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrTicketBuyerHistory)).
+		UseCustomWidget(func(gtx C) D {
+			if len(sessions) == 0 {
+				lbl := pg.Theme.Body1(values.String(values.StrNoTicketBuyerSessions))
+				lbl.Color = pg.Theme.Color.GrayText3
+				return lbl.Layout(gtx)
+			}
+
+			children := make([]layout.FlexChild, 0, len(sessions))
+			for i, session := range sessions {
+				session := session
+				top := values.MarginPadding16
+				if i == 0 {
+					top = 0
+				}
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: top}.Layout(gtx, func(gtx C) D {
+						return pg.layoutTicketBuyerSession(gtx, session)
+					})
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	pg.ParentWindow().ShowModal(historyModal)
+}
+
+func (pg *Page) layoutTicketBuyerSession(gtx C, session *walletdata.TicketBuyerSession) D {
+	start := values.StringF(values.StrTicketBuyerSessionStart, utils.FormatDateOrTime(session.Start), session.StartReason)
+	stop := pg.Theme.Body2(values.String(values.StrTicketBuyerSessionRunning))
+	stop.Color = pg.Theme.Color.Success
+	switch {
+	case session.Stop > 0:
+		stop.Text = values.StringF(values.StrTicketBuyerSessionStop, utils.FormatDateOrTime(session.Stop), session.StopReason)
+		stop.Color = pg.Theme.Color.GrayText2
+	case session.StopReason != "":
+		// The app exited without stopping the session.
+		stop.Text = session.StopReason
+		stop.Color = pg.Theme.Color.GrayText2
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			lbl := pg.Theme.Body1(start)
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(stop.Layout),
+	)
+}
@@ -0,0 +1,40 @@
+package staking
+
+import (
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// updateTicketBuyerResume enables or disables resuming the ticket buyer after
+// the app restarts. Enabling it asks for the startup password, which the
+// wallet passphrase is stored encrypted with.
+func (pg *Page) updateTicketBuyerResume(passphrase string, resume bool) {
+	if !resume {
+		pg.dcrWallet.DisableTicketBuyerResume()
+		return
+	}
+	if pg.dcrWallet.IsTicketBuyerResumeEnabled() {
+		return
+	}
+
+	startupPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrConfirmStartupPass)).
+		PasswordHint(values.String(values.StrStartupPassword)).
+		SetPositiveButtonCallback(func(_, startupPassword string, pm *modal.CreatePasswordModal) bool {
+			if !utils.StringNotEmpty(startupPassword) {
+				pm.SetError(values.String(values.StrErrPassEmpty))
+				return false
+			}
+			err := pg.AssetsManager.EnableTicketBuyerResume(pg.dcrWallet.GetWalletID(), passphrase, startupPassword)
+			if err != nil {
+				pm.SetError(values.TranslateErr(err.Error()))
+				return false
+			}
+			pm.Dismiss()
+			return true
+		})
+	pg.ParentWindow().ShowModal(startupPasswordModal)
+}
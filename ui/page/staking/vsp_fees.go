@@ -0,0 +1,92 @@
+package staking
+
+import (
+	"gioui.org/font"
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+func (pg *Page) listenForVSPFeeReconciler() {
+	pg.vspFeeReport = pg.dcrWallet.VSPFeeReconcileReport()
+	listener := &dcr.VSPFeeReconcilerListener{
+		OnVSPFeeReconcileProgress: func(_ int, report *dcr.VSPFeeReconcileReport) {
+			pg.vspFeeReport = report
+			pg.ParentWindow().Reload()
+		},
+	}
+	err := pg.dcrWallet.AddVSPFeeReconcilerListener(listener, OverviewPageID)
+	if err != nil {
+		log.Errorf("Error adding VSP fee reconciler listener: %v", err)
+	}
+}
+
+func (pg *Page) stopVSPFeeReconcilerListener() {
+	pg.dcrWallet.RemoveVSPFeeReconcilerListener(OverviewPageID)
+}
+
+// showVSPFeesPasswordModal asks for the wallet passphrase and retries the VSP
+// fee payment of the tickets whose fee isn't confirmed.
+func (pg *Page) showVSPFeesPasswordModal() {
+	passwordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrPayVSPFees)).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if err := pg.dcrWallet.ReconcileVSPFees(password); err != nil {
+				pm.SetError(values.TranslateErr(err.Error()))
+				return false
+			}
+			pm.Dismiss()
+			return true
+		})
+	pg.ParentWindow().ShowModal(passwordModal)
+}
+
+// vspFeesSection shows the progress of the VSP fee reconciler while it runs
+// and the tickets whose fee still needs to be paid afterwards. It's hidden if
+// all the fees are confirmed.
+func (pg *Page) vspFeesSection(gtx C) D {
+	report := pg.vspFeeReport
+	if report == nil || (!report.Running && report.Unpaid == 0) {
+		return D{}
+	}
+
+	return pg.pageSections(gtx, func(gtx C) D {
+		textSize14 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize14)
+		textSize16 := values.TextSizeTransform(pg.IsMobileView(), values.TextSize16)
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx C) D {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						title := pg.Theme.Label(textSize16, values.String(values.StrVSPFees))
+						title.Font.Weight = font.SemiBold
+						return title.Layout(gtx)
+					}),
+					layout.Rigid(func(gtx C) D {
+						var status string
+						switch {
+						case report.Running:
+							status = values.StringF(values.StrCheckingVSPFees, report.Checked, report.Total)
+						case report.Locked > 0 || report.Failed > 0:
+							status = values.StringF(values.StrUnpaidVSPFees, report.Locked+report.Failed)
+						default:
+							status = values.StringF(values.StrVSPFeesReconciled, report.Retried, report.Moved)
+						}
+						lbl := pg.Theme.Label(textSize14, status)
+						lbl.Color = pg.Theme.Color.GrayText2
+						return lbl.Layout(gtx)
+					}),
+				)
+			}),
+			layout.Rigid(func(gtx C) D {
+				if report.Running || report.Locked+report.Failed == 0 || pg.dcrWallet.IsWatchingOnlyWallet() {
+					return D{}
+				}
+				return pg.payVSPFeesBtn.Layout(gtx)
+			}),
+		)
+	})
+}
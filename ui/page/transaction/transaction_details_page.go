@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -62,6 +63,7 @@ type TxDetailsPage struct {
 	associatedTicketClickable *cryptomaterial.Clickable
 	hashClickable             *cryptomaterial.Clickable
 	rebroadcastClickable      *cryptomaterial.Clickable
+	speedUpClickable          *cryptomaterial.Clickable
 	moreOption                *cryptomaterial.Clickable
 	outputsCollapsible        *cryptomaterial.Collapsible
 	inputsCollapsible         *cryptomaterial.Collapsible
@@ -72,6 +74,7 @@ type TxDetailsPage struct {
 
 	backButton  cryptomaterial.IconButton
 	rebroadcast cryptomaterial.Label
+	speedUp     cryptomaterial.Label
 
 	copyURLBtn *cryptomaterial.Clickable
 
@@ -91,12 +94,32 @@ type TxDetailsPage struct {
 	vspHostFees                           string
 
 	moreOptionIsOpen bool
+	canBumpFee       bool
+	canCPFP          bool
+}
+
+// feeBumper is implemented by the assets that can replace an unmined
+// transaction with a higher fee one.
+type feeBumper interface {
+	CanBumpFee(txHash string) bool
+	EstimateBumpFee(txHash string, newFeeRatePerkvB int64) (*sharedW.Amount, error)
+	BumpFee(txHash string, newFeeRatePerkvB int64, privatePassphrase string) (string, error)
+}
+
+// cpfpBuilder is implemented by the assets that can accelerate an unmined
+// transaction by spending its outputs with a child paying a higher fee.
+type cpfpBuilder interface {
+	CanCPFP(txHash string) bool
+	PrepareCPFP(txHash string, feeRatePerkvB int64) (*sharedW.CPFPInfo, error)
+	CPFP(txHash string, feeRatePerkvB int64, privatePassphrase string) (string, error)
 }
 
 func NewTransactionDetailsPage(l *load.Load, wallet sharedW.Asset, transaction *sharedW.Transaction) *TxDetailsPage {
 	rebroadcast := l.Theme.Label(values.TextSize14, values.String(values.StrRebroadcast))
 	rebroadcast.TextSize = values.TextSize14
 	rebroadcast.Color = l.Theme.Color.Text
+	speedUp := l.Theme.Label(values.TextSize14, values.String(values.StrSpeedUp))
+	speedUp.Color = l.Theme.Color.Text
 	pg := &TxDetailsPage{
 		Load:             l,
 		GenericPageModal: app.NewGenericPageModal(TransactionDetailsPageID),
@@ -127,6 +150,8 @@ func NewTransactionDetailsPage(l *load.Load, wallet sharedW.Asset, transaction *
 		rebroadcast:            rebroadcast,
 		rebroadcastClickable:   l.Theme.NewClickable(true),
 		rebroadcastIcon:        l.Theme.Icons.Rebroadcast,
+		speedUp:                speedUp,
+		speedUpClickable:       l.Theme.NewClickable(true),
 		txDestinationAddresses: make([]string, 0),
 	}
 
@@ -271,6 +296,15 @@ func (pg *TxDetailsPage) OnNavigatedTo() {
 		pg.title = values.String(values.StrTicketDetails)
 	}
 
+	pg.canBumpFee, pg.canCPFP = false, false
+	if bumper, ok := pg.wallet.(feeBumper); ok && pg.transaction.BlockHeight == -1 &&
+		pg.transaction.Direction == txhelper.TxDirectionSent {
+		pg.canBumpFee = bumper.CanBumpFee(pg.transaction.Hash)
+	}
+	if builder, ok := pg.wallet.(cpfpBuilder); ok && pg.transaction.BlockHeight == -1 && !pg.canBumpFee {
+		pg.canCPFP = builder.CanCPFP(pg.transaction.Hash)
+	}
+
 	pg.getTXSourceAccountAndDirection()
 	pg.txnWidgets = pg.initTxnWidgets()
 }
@@ -504,30 +538,13 @@ func (pg *TxDetailsPage) txDetailsHeader(gtx C) D {
 								if !pg.rebroadcastClickable.Enabled() {
 									gtx = pg.rebroadcastClickable.SetEnabled(false, &gtx)
 								}
-								return cryptomaterial.LinearLayout{
-									Width:     cryptomaterial.WrapContent,
-									Height:    cryptomaterial.WrapContent,
-									Clickable: pg.rebroadcastClickable,
-									Direction: layout.Center,
-									Alignment: layout.Middle,
-									Border: cryptomaterial.Border{
-										Color:  pg.Theme.Color.Gray2,
-										Width:  values.MarginPadding1,
-										Radius: cryptomaterial.Radius(10),
-									},
-									Padding: layout.Inset{
-										Top:    values.MarginPadding3,
-										Bottom: values.MarginPadding3,
-										Left:   values.MarginPadding8,
-										Right:  values.MarginPadding8,
-									},
-									Margin: layout.Inset{Left: values.MarginPadding10},
-								}.Layout(gtx,
-									layout.Rigid(func(gtx C) D {
-										return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, pg.rebroadcastIcon.Layout16dp)
-									}),
-									layout.Rigid(pg.rebroadcast.Layout),
-								)
+								return pg.actionButton(gtx, pg.rebroadcastClickable, pg.rebroadcastIcon, pg.rebroadcast)
+							}
+							return D{}
+						}),
+						layout.Rigid(func(gtx C) D {
+							if pg.transaction.BlockHeight == -1 && (pg.canBumpFee || pg.canCPFP) {
+								return pg.actionButton(gtx, pg.speedUpClickable, nil, pg.speedUp)
 							}
 							return D{}
 						}),
@@ -538,6 +555,36 @@ func (pg *TxDetailsPage) txDetailsHeader(gtx C) D {
 	)
 }
 
+func (pg *TxDetailsPage) actionButton(gtx C, clickable *cryptomaterial.Clickable, icon *cryptomaterial.Image, lbl cryptomaterial.Label) D {
+	return cryptomaterial.LinearLayout{
+		Width:     cryptomaterial.WrapContent,
+		Height:    cryptomaterial.WrapContent,
+		Clickable: clickable,
+		Direction: layout.Center,
+		Alignment: layout.Middle,
+		Border: cryptomaterial.Border{
+			Color:  pg.Theme.Color.Gray2,
+			Width:  values.MarginPadding1,
+			Radius: cryptomaterial.Radius(10),
+		},
+		Padding: layout.Inset{
+			Top:    values.MarginPadding3,
+			Bottom: values.MarginPadding3,
+			Left:   values.MarginPadding8,
+			Right:  values.MarginPadding8,
+		},
+		Margin: layout.Inset{Left: values.MarginPadding10},
+	}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			if icon == nil {
+				return D{}
+			}
+			return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, icon.Layout16dp)
+		}),
+		layout.Rigid(lbl.Layout),
+	)
+}
+
 func (pg *TxDetailsPage) getTimeToMatureOrExpire() int {
 	var progress float32
 	if dcrImpl, ok := pg.wallet.(*dcr.Asset); ok {
@@ -976,6 +1023,14 @@ func (pg *TxDetailsPage) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	if pg.speedUpClickable.Clicked(gtx) {
+		if pg.canBumpFee {
+			pg.showSpeedUpModal()
+		} else {
+			pg.showCPFPModal()
+		}
+	}
+
 	if pg.rebroadcastClickable.Clicked(gtx) {
 		go func() {
 			pg.rebroadcastClickable.SetEnabled(false, nil)
@@ -1006,6 +1061,127 @@ func (pg *TxDetailsPage) HandleUserInteractions(gtx C) {
 	}
 }
 
+// showSpeedUpModal asks for the fee rate the replacement of the transaction
+// should pay, then shows the fee it would pay for confirmation.
+func (pg *TxDetailsPage) showSpeedUpModal() {
+	bumper, ok := pg.wallet.(feeBumper)
+	if !ok {
+		return
+	}
+
+	ratesUnit := pg.feeRatesUnit()
+	txHash := pg.transaction.Hash
+	feeRateModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.StringF(values.StrNewFeeRate, ratesUnit)).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(feeRate string, tim *modal.TextInputModal) bool {
+			rate, err := strconv.ParseInt(strings.TrimSpace(feeRate), 10, 64)
+			if err != nil || rate <= 0 {
+				tim.SetError(values.String(values.StrInvalidAmount))
+				return false
+			}
+
+			newFee, err := bumper.EstimateBumpFee(txHash, rate)
+			if err != nil {
+				tim.SetError(err.Error())
+				return false
+			}
+
+			confirmModal := modal.NewCreatePasswordModal(pg.Load).
+				EnableName(false).
+				EnableConfirmPassword(false).
+				Title(values.String(values.StrSpeedUpTx)).
+				SetDescription(values.StringF(values.StrSpeedUpTxDesc, rate, ratesUnit,
+					pg.wallet.ToAmount(pg.transaction.Fee).String(), pg.wallet.ToAmount(newFee.UnitValue).String())).
+				PasswordHint(values.String(values.StrSpendingPassword)).
+				SetPositiveButtonCallback(func(_, password string, m *modal.CreatePasswordModal) bool {
+					if _, err := bumper.BumpFee(txHash, rate, password); err != nil {
+						m.SetError(err.Error())
+						return false
+					}
+
+					infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrTxSpedUp), modal.DefaultClickFunc())
+					pg.ParentWindow().ShowModal(infoModal)
+					// The transaction shown on this page has been replaced.
+					pg.ParentNavigator().CloseCurrentPage()
+					return true
+				})
+			pg.ParentWindow().ShowModal(confirmModal)
+			return true
+		})
+	feeRateModal.Title(values.String(values.StrSpeedUpTx)).
+		SetPositiveButtonText(values.String(values.StrNext))
+	pg.ParentWindow().ShowModal(feeRateModal)
+}
+
+// showCPFPModal asks for the fee rate the transaction and a child spending
+// its outputs should reach together, then shows the resulting package fee
+// math for confirmation.
+func (pg *TxDetailsPage) showCPFPModal() {
+	builder, ok := pg.wallet.(cpfpBuilder)
+	if !ok {
+		return
+	}
+
+	ratesUnit := pg.feeRatesUnit()
+	txHash := pg.transaction.Hash
+	feeRateModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.StringF(values.StrTargetFeeRate, ratesUnit)).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(feeRate string, tim *modal.TextInputModal) bool {
+			rate, err := strconv.ParseInt(strings.TrimSpace(feeRate), 10, 64)
+			if err != nil || rate <= 0 {
+				tim.SetError(values.String(values.StrInvalidAmount))
+				return false
+			}
+
+			info, err := builder.PrepareCPFP(txHash, rate)
+			if err != nil {
+				tim.SetError(err.Error())
+				return false
+			}
+
+			parentFee := values.String(values.StrUnknown)
+			if info.ParentFeeKnown {
+				parentFee = pg.wallet.ToAmount(info.ParentFee.UnitValue).String()
+			}
+			summary := values.StringF(values.StrCPFPSummary, info.ParentSize, parentFee,
+				info.ChildSize, pg.wallet.ToAmount(info.ChildFee.UnitValue).String(),
+				pg.wallet.ToAmount(info.ChildAmount.UnitValue).String(),
+				info.PackageFeeRate, ratesUnit, info.TargetFeeRate, ratesUnit)
+
+			confirmModal := modal.NewCreatePasswordModal(pg.Load).
+				EnableName(false).
+				EnableConfirmPassword(false).
+				Title(values.String(values.StrSpeedUpTx)).
+				SetDescription(summary).
+				PasswordHint(values.String(values.StrSpendingPassword)).
+				SetPositiveButtonCallback(func(_, password string, m *modal.CreatePasswordModal) bool {
+					if _, err := builder.CPFP(txHash, rate, password); err != nil {
+						m.SetError(err.Error())
+						return false
+					}
+
+					pg.canCPFP = false
+					infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrTxAccelerated), modal.DefaultClickFunc())
+					pg.ParentWindow().ShowModal(infoModal)
+					return true
+				})
+			pg.ParentWindow().ShowModal(confirmModal)
+			return true
+		})
+	feeRateModal.Title(values.String(values.StrSpeedUpTx)).
+		SetPositiveButtonText(values.String(values.StrNext))
+	pg.ParentWindow().ShowModal(feeRateModal)
+}
+
+func (pg *TxDetailsPage) feeRatesUnit() string {
+	if pg.wallet.GetAssetType() == libutils.LTCWalletAsset {
+		return "Lit/kvB"
+	}
+	return "Sat/kvB"
+}
+
 func (pg *TxDetailsPage) initTxnWidgets() transactionWdg {
 	var txn transactionWdg
 
@@ -1,13 +1,10 @@
 package transaction
 
 import (
-	"encoding/csv"
 	"fmt"
-	"math"
-	"os"
 	"path/filepath"
-	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,7 +16,7 @@ import (
 
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
-	"github.com/crypto-power/cryptopower/libwallet/txhelper"
+	"github.com/crypto-power/cryptopower/libwallet/txexport"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
@@ -652,31 +649,7 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 	}
 
 	if pg.exportBtn.Clicked(gtx) {
-		exportModal := modal.NewCustomModal(pg.Load).
-			Title(values.String(values.StrExportTransaction)).
-			Body(values.String(values.StrExportTransactionsMsg)).
-			SetNegativeButtonText(values.String(values.StrCancel)).
-			SetPositiveButtonText(values.String(values.StrExport)).
-			SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
-				assets := []sharedW.Asset{pg.selectedWallet}
-				if pg.selectedWallet == nil {
-					assets = pg.assetWallets
-				}
-				go func() {
-					fileName := filepath.Join(pg.AssetsManager.RootDir(), "exports", fmt.Sprintf("transaction_export_%d.csv", time.Now().Unix()))
-					err := exportTxs(assets, fileName)
-					if err != nil {
-						errModal := modal.NewErrorModal(pg.Load, fmt.Errorf("error exporting your wallet(s) transactions: %v", err).Error(), modal.DefaultClickFunc())
-						pg.ParentWindow().ShowModal(errModal)
-						return
-					}
-
-					infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrExportTransactionSuccessMsg, fileName), modal.DefaultClickFunc())
-					pg.ParentWindow().ShowModal(infoModal)
-				}()
-				return true
-			})
-		pg.ParentWindow().ShowModal(exportModal)
+		pg.showExportModal()
 	}
 
 	if pg.orderDropDown.Changed(gtx) {
@@ -691,62 +664,86 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 	}
 }
 
-func exportTxs(assets []sharedW.Asset, fileName string) error {
-	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
-		return fmt.Errorf("os.MkdirAll error: %w", err)
-	}
-
-	var success bool
-	defer func() {
-		if !success {
-			os.Remove(fileName)
-		}
-	}()
-
-	f, err := os.Create(fileName)
-	if err != nil {
-		return fmt.Errorf("os.Create error: %w", err)
-	}
-	defer f.Close()
-
-	headers := []string{values.String(values.StrTime), values.String(values.StrHash), values.String(values.StrType), values.String(values.StrDirection), values.String(values.StrFee), values.String(values.StrAmount)}
-
-	writer := csv.NewWriter(f)
-	writer.UseCRLF = runtime.GOOS == "windows"
-	err = writer.Write(headers)
-	if err != nil {
-		return fmt.Errorf("csv.Writer.Write error: %w", err)
-	}
-
-	for _, a := range assets {
-		txs, err := a.GetTransactionsRaw(0, math.MaxInt32, utils.TxFilterAll, true, "")
+// showExportModal asks for the export format and exports the transactions of
+// the selected wallet, or of all the wallets if none is selected, in the
+// background. The transactions of a single account of the selected wallet can
+// be exported too.
+func (pg *TransactionsPage) showExportModal() {
+	formatGroup := new(widget.Enum)
+	formatGroup.Value = string(txexport.CSV)
+	csvBtn := pg.Theme.RadioButton(formatGroup, string(txexport.CSV), values.String(values.StrCSV), pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary)
+	jsonBtn := pg.Theme.RadioButton(formatGroup, string(txexport.JSON), values.String(values.StrJSON), pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary)
+
+	accountGroup := new(widget.Enum)
+	accountGroup.Value = strconv.Itoa(int(txexport.AllAccounts))
+	var accountBtns []cryptomaterial.RadioButton
+	if pg.selectedWallet != nil {
+		accounts, err := pg.selectedWallet.GetAccountsRaw()
 		if err != nil {
-			return fmt.Errorf("wallet.GetTransactionsRaw error: %w", err)
+			log.Errorf("Error getting accounts: %v", err)
+		} else if len(accounts.Accounts) > 1 {
+			accountBtns = append(accountBtns, pg.Theme.RadioButton(accountGroup, accountGroup.Value, values.String(values.StrAllAccounts), pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary))
+			for _, account := range accounts.Accounts {
+				accountBtns = append(accountBtns, pg.Theme.RadioButton(accountGroup, strconv.Itoa(int(account.Number)), account.Name, pg.Theme.Color.DeepBlue, pg.Theme.Color.Primary))
+			}
 		}
+	}
 
-		// Write txs to file.
-		for _, tx := range txs {
-			err := writer.Write([]string{
-				time.Unix(tx.Timestamp, 0).String(),
-				tx.Hash,
-				tx.Type,
-				txhelper.TxDirectionString(tx.Direction),
-				a.ToAmount(tx.Fee).String(),
-				a.ToAmount(tx.Amount).String(),
-			})
-			if err != nil {
-				return fmt.Errorf("csv.Writer.Write error: %v", err)
+	exportModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrExportTransaction)).
+		Body(values.String(values.StrExportTransactionsMsg)).
+		UseCustomWidget(func(gtx C) D {
+			children := []layout.FlexChild{
+				layout.Rigid(func(gtx C) D {
+					return layout.Flex{}.Layout(gtx,
+						layout.Rigid(csvBtn.Layout),
+						layout.Rigid(jsonBtn.Layout),
+					)
+				}),
 			}
-
-			writer.Flush()
-			if err = writer.Error(); err != nil {
-				return fmt.Errorf("csv.Writer error: %w", err)
+			if len(accountBtns) > 0 {
+				children = append(children, layout.Rigid(func(gtx C) D {
+					lbl := pg.Theme.Body1(values.String(values.StrAccount))
+					lbl.Font.Weight = font.SemiBold
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, lbl.Layout)
+				}))
 			}
-		}
-	}
+			for i := range accountBtns {
+				children = append(children, layout.Rigid(accountBtns[i].Layout))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrExport)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			assets := []sharedW.Asset{pg.selectedWallet}
+			if pg.selectedWallet == nil {
+				assets = pg.assetWallets
+			}
+			format := txexport.Format(formatGroup.Value)
+			account := txexport.AllAccounts
+			if number, err := strconv.Atoi(accountGroup.Value); err == nil {
+				account = int32(number)
+			}
+			go func() {
+				fileName := filepath.Join(pg.AssetsManager.RootDir(), "exports", fmt.Sprintf("transaction_export_%d.%s", time.Now().Unix(), format))
+				missingFiatValues, err := txexport.ToFile(fileName, format, assets, account, pg.AssetsManager.RateSource)
+				if err != nil {
+					errModal := modal.NewErrorModal(pg.Load, fmt.Errorf("error exporting your wallet(s) transactions: %v", err).Error(), modal.DefaultClickFunc())
+					pg.ParentWindow().ShowModal(errModal)
+					return
+				}
 
-	success = true
-	return nil
+				msg := values.StringF(values.StrExportTransactionSuccessMsg, fileName)
+				if missingFiatValues > 0 {
+					msg += " " + values.StringF(values.StrExportMissingFiatValues, missingFiatValues)
+				}
+				infoModal := modal.NewSuccessModal(pg.Load, msg, modal.DefaultClickFunc())
+				pg.ParentWindow().ShowModal(infoModal)
+			}()
+			return true
+		})
+	pg.ParentWindow().ShowModal(exportModal)
 }
 
 // Update transaction list when there is new tx or new confirmed status
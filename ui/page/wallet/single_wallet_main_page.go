@@ -226,8 +226,10 @@ func (swmp *SingleWalletMasterPage) initTabOptions() {
 		values.StrSettings,
 	}
 
-	if !swmp.selectedWallet.IsWatchingOnlyWallet() {
-		// Add 'Send' to the tabs for non-watching-only wallets.
+	hasSendTab := !swmp.selectedWallet.IsWatchingOnlyWallet() || send.SupportsOfflineSigning(swmp.selectedWallet)
+	if hasSendTab {
+		// Add 'Send' to the tabs for non-watching-only wallets, and for
+		// watch-only wallets that can export unsigned transactions.
 		sendTab := []string{values.StrSend}
 		// Insert 'Send' after 'StrInfo'.
 		commonTabs = append(commonTabs[:1], append(sendTab, commonTabs[1:]...)...)
@@ -250,7 +252,7 @@ func (swmp *SingleWalletMasterPage) initTabOptions() {
 		insertIndex := 3 // Default position before 'StrAccounts' in the commonTabs.
 
 		// If 'Send' has been added, adjust the insertIndex accordingly.
-		if !swmp.selectedWallet.IsWatchingOnlyWallet() {
+		if hasSendTab {
 			insertIndex++
 		}
 
@@ -758,6 +760,8 @@ func (swmp *SingleWalletMasterPage) postProposalNotification(propName string, st
 		notification = values.StringF(values.StrVoteStartedNotif, propName)
 	case libutils.ProposalStatusVoteFinished:
 		notification = values.StringF(values.StrVoteEndedNotif, propName)
+	case libutils.ProposalStatusEdited:
+		notification = values.StringF(values.StrProposalEditedNotif, propName)
 	default:
 		notification = values.StringF(values.StrNewProposalUpdate, propName)
 	}
@@ -65,6 +65,7 @@ type SettingsPage struct {
 
 	spendUnconfirmed  *cryptomaterial.Switch
 	spendUnmixedFunds *cryptomaterial.Switch
+	strictPrivacy     *cryptomaterial.Switch
 	connectToPeer     *cryptomaterial.Switch
 
 	walletCallbackFunc func()
@@ -95,6 +96,7 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 
 		spendUnconfirmed:  l.Theme.Switch(),
 		spendUnmixedFunds: l.Theme.Switch(),
+		strictPrivacy:     l.Theme.Switch(),
 		connectToPeer:     l.Theme.Switch(),
 
 		pageContainer: &widget.List{
@@ -117,6 +119,7 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 func (pg *SettingsPage) OnNavigatedTo() {
 	pg.spendUnconfirmed.SetChecked(pg.readBool(sharedW.SpendUnconfirmedConfigKey))
 	pg.spendUnmixedFunds.SetChecked(pg.readBool(sharedW.SpendUnmixedFundsKey))
+	pg.strictPrivacy.SetChecked(pg.readBool(sharedW.StrictPrivacySendConfigKey))
 
 	pg.loadPeerAddress()
 
@@ -202,6 +205,12 @@ func (pg *SettingsPage) generalSection() layout.Widget {
 				}
 				return D{}
 			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.wallet.GetAssetType() == libutils.DCRWalletAsset {
+					return pg.subSection(gtx, values.String(values.StrStrictPrivacySend), pg.strictPrivacy.Layout)
+				}
+				return D{}
+			}),
 			layout.Rigid(func(gtx C) D {
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(pg.subSectionSwitch(values.String(values.StrConnectToSpecificPeer), pg.connectToPeer)),
@@ -771,6 +780,14 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 		}
 	}
 
+	if pg.strictPrivacy.Changed(gtx) {
+		if err := pg.wallet.(*dcr.Asset).SetStrictPrivacySend(pg.strictPrivacy.IsChecked()); err != nil {
+			pg.strictPrivacy.SetChecked(false)
+			info := modal.NewErrorModal(pg.Load, values.String(values.StrMixingNotSetUp), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(info)
+		}
+	}
+
 	if pg.connectToPeer.Changed(gtx) && !pg.isPrivacyModeOn() {
 		if pg.connectToPeer.IsChecked() {
 			pg.showSPVPeerDialog()
@@ -807,27 +824,7 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 	}
 
 	for pg.addAccount.Clicked(gtx) {
-		newPasswordModal := modal.NewCreatePasswordModal(pg.Load).
-			Title(values.String(values.StrCreateNewAccount)).
-			EnableName(true).
-			NameHint(values.String(values.StrAcctName)).
-			EnableConfirmPassword(false).
-			PasswordHint(values.String(values.StrSpendingPassword)).
-			SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
-				_, err := pg.wallet.CreateNewAccount(accountName, password)
-				if err != nil {
-					m.SetError(err.Error())
-					return false
-				}
-				pg.loadWalletAccount()
-				m.Dismiss()
-
-				info := modal.NewSuccessModal(pg.Load, values.StringF(values.StrAcctCreated),
-					modal.DefaultClickFunc())
-				pg.ParentWindow().ShowModal(info)
-				return true
-			})
-		pg.ParentWindow().ShowModal(newPasswordModal)
+		components.ShowCreateAccountModal(pg.Load, pg.ParentWindow(), pg.wallet, pg.loadWalletAccount)
 		break
 	}
 }
@@ -911,7 +911,7 @@ const EN = `
 "createAssetWalletToVoteMsg" = "You need to create a %s wallet to vote."
 "getTicketsNow" = "Get Tickets Now"
 "exportTransaction" = "Export Transaction"
-"exportTransactionsMsg" = "Export the transactions recorded in your wallet(s). Your transactions will be processed in the background and you'll be notified when it's ready."
+"exportTransactionsMsg" = "Export the transactions recorded in your wallet(s). Your transactions will be processed in the background and you'll be notified when it's ready. Fiat values are included where the selected exchange rate source provides historical rates."
 "time" = "Time"
 "direction" = "Direction"
 "exportTransactionSuccessMsg" = "Your transactions have been exported successfully and saved to %s."
@@ -978,4 +978,147 @@ const EN = `
 "lowStorageSpaceBody" = "Your device storage space is low and is not enough to sync a wallet. Required space to sync a wallet is ~%dmb while your free internal memory is %dmb"
 "walletCreationLimitTitle" = "Wallet creation limit"
 "walletCreationLimitBody" = "Limit of 1 wallet per 1 gig of ram on the device. You can create up to 1 wallet for every 1 gigabyte of RAM available on your device."
+"speedUp" = "Speed up"
+"speedUpTx" = "Speed up transaction"
+"speedUpTxDesc" = "Replace this transaction with one paying %d %s. The extra fee is deducted from the change. Current fee: %s. New fee: %s."
+"newFeeRate" = "New fee rate (%s)"
+"txSpedUp" = "Transaction replaced with a higher fee one"
+"targetFeeRate" = "Target fee rate (%s)"
+"cpfpSummary" = "A new transaction will spend the outputs of this one back to your wallet so both confirm together. Parent: %d vB, fee %s. Child: %d vB, fee %s, receives %s. Package fee rate: %d %s, target %d %s."
+"txAccelerated" = "Transaction accelerated"
+"addressType" = "Address type"
+"nativeSegwit" = "Native SegWit (P2WPKH)"
+"nestedSegwit" = "Nested SegWit (P2SH-P2WPKH)"
+"taproot" = "Taproot (P2TR)"
+"freeze" = "Freeze"
+"frozen" = "Frozen"
+"outputFrozen" = "Output frozen, it will only be spent when selected manually"
+"outputUnfrozen" = "Output unfrozen"
+"requestedAmount" = "Requested amount (optional)"
+"paymentURIWrongAsset" = "This payment request is for a %v wallet"
+"noWalletForPaymentURI" = "Add a spending %v wallet to pay this request"
+"addressBook" = "Address book"
+"addContact" = "Add contact"
+"contactName" = "Contact name"
+"noContacts" = "No saved contacts"
+"contactAddressInvalid" = "This address is not valid on the current network"
+"invalidContactsWarning" = "%d address book contact(s) are not valid on the current network"
+"csv" = "CSV"
+"json" = "JSON"
+"addBackupVSP" = "Add backup VSP"
+"maxVSPFee" = "Maximum VSP fee in % (optional)"
+"vspFees" = "VSP fees"
+"payVSPFees" = "Pay VSP fees"
+"checkingVSPFees" = "Checking the VSP fees of your tickets (%d/%d)"
+"unpaidVSPFees" = "%d ticket(s) have an unpaid VSP fee and may miss their votes"
+"vspFeesReconciled" = "Fee payment retried for %d ticket(s), %d ticket(s) moved to another VSP"
+"votingMode" = "Voting mode"
+"soloVoting" = "Solo voting"
+"soloVotingInfo" = "This wallet votes its own tickets through the dcrd node at %s. Votes are only cast while the app is open, synced and the ticket buyer is running, which keeps the wallet unlocked. Otherwise its tickets miss their votes."
+"vspVotingInfo" = "Tickets are voted by the VSP they were bought with."
+"setUpSoloVoting" = "Set up solo voting"
+"useVSP" = "Use a VSP"
+"soloVotingSetupMsg" = "Solo voting syncs this wallet with your own dcrd node instead of SPV peers so it can vote its tickets when they are called. No VSP fee is paid for the tickets bought in this mode. The wallet must stay unlocked to vote, keep the ticket buyer running to keep it unlocked. The RPC password is saved encrypted with the spending passphrase, the wallet syncs with SPV peers after a restart until the ticket buyer is started."
+"dcrdRPCAddress" = "dcrd RPC address"
+"rpcUser" = "RPC username"
+"rpcPassword" = "RPC password"
+"rpcCertPath" = "RPC certificate file (optional)"
+"soloTicketBuyerInfo" = "Tickets will be bought for solo voting, without a VSP."
+"stakingRewards" = "Staking rewards"
+"annualizedReturn" = "Annualized return"
+"avgTicketReturn" = "Average ticket return"
+"daily" = "Daily"
+"weekly" = "Weekly"
+"monthly" = "Monthly"
+"votedRevokedCount" = "%d voted, %d revoked"
+"ticketFees" = "Ticket fees"
+"netRewards" = "Net rewards"
+"noStakingRewards" = "No ticket has voted or been revoked yet."
+"ticketBuyerLimits" = "Spending limits (optional)"
+"maxTicketPrice" = "Max ticket price (DCR)"
+"maxTicketsPerDay" = "Max tickets per day"
+"maxTicketsPerWindow" = "Max tickets per price window"
+"activeFromHour" = "Buy from hour (0-23)"
+"activeToHour" = "Buy until hour (0-23)"
+"resumeTicketBuyer" = "Resume the ticket buyer when the app is unlocked after a restart"
+"mixerStatistics" = "Mixer statistics"
+"mixes" = "Mixes"
+"mixesPerDay" = "%d (%.1f per day)"
+"mixedAmount" = "Mixed amount"
+"mixFeesPaid" = "Fees paid"
+"unmixedRemaining" = "Unmixed balance remaining"
+"denominations" = "Denominations"
+"mixesOnDay" = "%d mixes, %s"
+"noMixesYet" = "No mixes yet"
+"mixingWindows" = "Mixing windows"
+"mixAnyTime" = "Any time"
+"mixingWindowsMsg" = "Only mix during these hours of the day, e.g. 22-6, 12-14. Leave empty to mix at any time. Changes apply the next time the mixer starts."
+"mixingWindowsHint" = "Hours, e.g. 22-6"
+"invalidMixingWindows" = "Enter start-end hours between 0 and 23, separated by commas"
+"waitingForMixingWindow" = "Waiting for the next mixing window"
+"strictPrivacySend" = "Never link mixed and unmixed coins when sending"
+"addressReuseWarning" = "Sending to a used address reduces privacy: %s"
+"treasurySpends" = "Treasury spends"
+"tspendPolicyMsg" = "Vote on a single treasury spend. Abstaining follows the policy for its Pi key."
+"noPendingTSpends" = "No pending treasury spends"
+"tspendHash" = "Treasury spend hash"
+"tspendExpiry" = "%s, expires at block %d"
+"ticketVotes" = "Ticket votes"
+"ticketVotesMsg" = "Choices decoded from each vote, compared with the choices the ticket is set to vote for now."
+"noTicketVotes" = "None of the wallet's tickets has voted yet"
+"ticketChoice" = "ticket choice"
+"nowSetTo" = "now set to %s"
+"votedAtBlock" = "Voted at block %d, %s"
+"commentsCount" = "Comments (%d)"
+"noComments" = "No comments yet"
+"commentDeleted" = "Comment deleted"
+"commentVotes" = "%d up, %d down"
+"proposalEditedNotif" = "A proposal under discussion was edited: %s"
+"compareVersions" = "Compare versions"
+"fromVersion" = "From version"
+"toVersion" = "To version"
+"noChanges" = "No changes"
+"voteReceipts" = "Vote receipts"
+"voteReceiptsMsg" = "Receipts signed by the Politeia server for the votes cast from this device. They can be verified without the server to prove how your tickets voted."
+"noVoteReceipts" = "No votes cast from this device"
+"receiptVerified" = "Verified"
+"receiptInvalid" = "Invalid receipt"
+"copyReceipts" = "Copy receipts"
+"voteWithAllWallets" = "Vote with all wallets"
+"walletVoteCounts" = "%d yes, %d no"
+"voteResults" = "Vote results"
+"unlockWalletToVote" = "Unlock %s to vote"
+"treasuryFunding" = "Treasury funding"
+"treasuryFundingMsg" = "Budgets of the approved proposals compared with the treasury's balance and spending. Budgets are assumed to be paid evenly over their funding period."
+"treasuryBalance" = "Treasury balance"
+"averageMonthlySpending" = "Average monthly spending"
+"spendRateValue" = "%s over 3 months, %s over 12 months"
+"runway" = "Runway"
+"runwayValue" = "%d months at the 12 month spending rate"
+"remainingBudgets" = "Remaining approved budgets"
+"monthlyActivity" = "Monthly activity"
+"monthActivity" = "Received %s, spent %s, budgeted %s"
+"approvedBudgets" = "Approved budgets"
+"fundingPeriod" = "%s, %s to %s"
+"noFundedProposals" = "No approved proposals with a budget were synced yet."
+"budgetRemaining" = "%s remaining"
+"allAccounts" = "All accounts"
+"ticketBuyerHistory" = "Ticket buyer history"
+"noTicketBuyerSessions" = "The ticket buyer has not run yet"
+"ticketBuyerSessionRunning" = "Running"
+"ticketBuyerSessionStart" = "Started %s: %s"
+"ticketBuyerSessionStop" = "Stopped %s: %s"
+"resumeTicketBuyerWarning" = "Your spending password will be stored on this device, encrypted with your startup password."
+"verifyReceipts" = "Verify receipts"
+"pasteVoteReceipts" = "Paste exported vote receipts"
+"importedVoteReceiptsMsg" = "Receipts verified against the Politeia server key saved by this app."
+"disableSoloVotingWarning" = "This wallet has %d unspent tickets that aren't registered with a VSP. Only this wallet can vote them and it stops doing so in VSP mode, they will miss their votes. Use a VSP anyway?"
+"soloVotingPassRequired" = "The spending passphrase is required to save the RPC password encrypted."
+"walletVoteDetailsErr" = "%s can't vote: %s"
+"exportUnsignedTx" = "Export unsigned transaction"
+"importTransaction" = "Import transaction"
+"unsignedTxExported" = "The unsigned transaction was saved to %s. Sign it with a wallet holding its keys, then import the signed transaction here to broadcast it."
+"txFileOrData" = "Path of the transaction file, or its content"
+"signedTxExported" = "The signed transaction was saved to %s. Import it in the watch-only wallet that exported it to broadcast it."
+"exportMissingFiatValues" = "%d of them have no fiat value as their exchange rate couldn't be fetched."
 `
@@ -1089,4 +1089,147 @@ const (
 	StrLowStorageSpaceBody                   = "lowStorageSpaceBody"
 	StrWalletsCreationLimitTitle             = "walletCreationLimitTitle"
 	StrWalletsCreationLimitBody              = "walletCreationLimitBody"
+	StrSpeedUp                               = "speedUp"
+	StrSpeedUpTx                             = "speedUpTx"
+	StrSpeedUpTxDesc                         = "speedUpTxDesc"
+	StrNewFeeRate                            = "newFeeRate"
+	StrTxSpedUp                              = "txSpedUp"
+	StrTargetFeeRate                         = "targetFeeRate"
+	StrCPFPSummary                           = "cpfpSummary"
+	StrTxAccelerated                         = "txAccelerated"
+	StrAddressType                           = "addressType"
+	StrNativeSegwit                          = "nativeSegwit"
+	StrNestedSegwit                          = "nestedSegwit"
+	StrTaproot                               = "taproot"
+	StrFreeze                                = "freeze"
+	StrFrozen                                = "frozen"
+	StrOutputFrozen                          = "outputFrozen"
+	StrOutputUnfrozen                        = "outputUnfrozen"
+	StrRequestedAmount                       = "requestedAmount"
+	StrPaymentURIWrongAsset                  = "paymentURIWrongAsset"
+	StrNoWalletForPaymentURI                 = "noWalletForPaymentURI"
+	StrAddressBook                           = "addressBook"
+	StrAddContact                            = "addContact"
+	StrContactName                           = "contactName"
+	StrNoContacts                            = "noContacts"
+	StrContactAddressInvalid                 = "contactAddressInvalid"
+	StrInvalidContactsWarning                = "invalidContactsWarning"
+	StrCSV                                   = "csv"
+	StrJSON                                  = "json"
+	StrAddBackupVSP                          = "addBackupVSP"
+	StrMaxVSPFee                             = "maxVSPFee"
+	StrVSPFees                               = "vspFees"
+	StrPayVSPFees                            = "payVSPFees"
+	StrCheckingVSPFees                       = "checkingVSPFees"
+	StrUnpaidVSPFees                         = "unpaidVSPFees"
+	StrVSPFeesReconciled                     = "vspFeesReconciled"
+	StrVotingMode                            = "votingMode"
+	StrSoloVoting                            = "soloVoting"
+	StrSoloVotingInfo                        = "soloVotingInfo"
+	StrVSPVotingInfo                         = "vspVotingInfo"
+	StrSetUpSoloVoting                       = "setUpSoloVoting"
+	StrUseVSP                                = "useVSP"
+	StrSoloVotingSetupMsg                    = "soloVotingSetupMsg"
+	StrDcrdRPCAddress                        = "dcrdRPCAddress"
+	StrRPCUser                               = "rpcUser"
+	StrRPCPassword                           = "rpcPassword"
+	StrRPCCertPath                           = "rpcCertPath"
+	StrSoloTicketBuyerInfo                   = "soloTicketBuyerInfo"
+	StrStakingRewards                        = "stakingRewards"
+	StrAnnualizedReturn                      = "annualizedReturn"
+	StrAvgTicketReturn                       = "avgTicketReturn"
+	StrDaily                                 = "daily"
+	StrWeekly                                = "weekly"
+	StrMonthly                               = "monthly"
+	StrVotedRevokedCount                     = "votedRevokedCount"
+	StrTicketFees                            = "ticketFees"
+	StrNetRewards                            = "netRewards"
+	StrNoStakingRewards                      = "noStakingRewards"
+	StrTicketBuyerLimits                     = "ticketBuyerLimits"
+	StrMaxTicketPrice                        = "maxTicketPrice"
+	StrMaxTicketsPerDay                      = "maxTicketsPerDay"
+	StrMaxTicketsPerWindow                   = "maxTicketsPerWindow"
+	StrActiveFromHour                        = "activeFromHour"
+	StrActiveToHour                          = "activeToHour"
+	StrResumeTicketBuyer                     = "resumeTicketBuyer"
+	StrMixerStatistics                       = "mixerStatistics"
+	StrMixes                                 = "mixes"
+	StrMixesPerDay                           = "mixesPerDay"
+	StrMixedAmount                           = "mixedAmount"
+	StrMixFeesPaid                           = "mixFeesPaid"
+	StrUnmixedRemaining                      = "unmixedRemaining"
+	StrDenominations                         = "denominations"
+	StrMixesOnDay                            = "mixesOnDay"
+	StrNoMixesYet                            = "noMixesYet"
+	StrMixingWindows                         = "mixingWindows"
+	StrMixAnyTime                            = "mixAnyTime"
+	StrMixingWindowsMsg                      = "mixingWindowsMsg"
+	StrMixingWindowsHint                     = "mixingWindowsHint"
+	StrInvalidMixingWindows                  = "invalidMixingWindows"
+	StrWaitingForMixingWindow                = "waitingForMixingWindow"
+	StrStrictPrivacySend                     = "strictPrivacySend"
+	StrAddressReuseWarning                   = "addressReuseWarning"
+	StrTreasurySpends                        = "treasurySpends"
+	StrTSpendPolicyMsg                       = "tspendPolicyMsg"
+	StrNoPendingTSpends                      = "noPendingTSpends"
+	StrTSpendHash                            = "tspendHash"
+	StrTSpendExpiry                          = "tspendExpiry"
+	StrTicketVotes                           = "ticketVotes"
+	StrTicketVotesMsg                        = "ticketVotesMsg"
+	StrNoTicketVotes                         = "noTicketVotes"
+	StrTicketChoice                          = "ticketChoice"
+	StrNowSetTo                              = "nowSetTo"
+	StrVotedAtBlock                          = "votedAtBlock"
+	StrCommentsCount                         = "commentsCount"
+	StrNoComments                            = "noComments"
+	StrCommentDeleted                        = "commentDeleted"
+	StrCommentVotes                          = "commentVotes"
+	StrProposalEditedNotif                   = "proposalEditedNotif"
+	StrCompareVersions                       = "compareVersions"
+	StrFromVersion                           = "fromVersion"
+	StrToVersion                             = "toVersion"
+	StrNoChanges                             = "noChanges"
+	StrVoteReceipts                          = "voteReceipts"
+	StrVoteReceiptsMsg                       = "voteReceiptsMsg"
+	StrNoVoteReceipts                        = "noVoteReceipts"
+	StrReceiptVerified                       = "receiptVerified"
+	StrReceiptInvalid                        = "receiptInvalid"
+	StrCopyReceipts                          = "copyReceipts"
+	StrVoteWithAllWallets                    = "voteWithAllWallets"
+	StrWalletVoteCounts                      = "walletVoteCounts"
+	StrVoteResults                           = "voteResults"
+	StrUnlockWalletToVote                    = "unlockWalletToVote"
+	StrTreasuryFunding                       = "treasuryFunding"
+	StrTreasuryFundingMsg                    = "treasuryFundingMsg"
+	StrTreasuryBalance                       = "treasuryBalance"
+	StrAverageMonthlySpending                = "averageMonthlySpending"
+	StrSpendRateValue                        = "spendRateValue"
+	StrRunway                                = "runway"
+	StrRunwayValue                           = "runwayValue"
+	StrRemainingBudgets                      = "remainingBudgets"
+	StrMonthlyActivity                       = "monthlyActivity"
+	StrMonthActivity                         = "monthActivity"
+	StrApprovedBudgets                       = "approvedBudgets"
+	StrFundingPeriod                         = "fundingPeriod"
+	StrNoFundedProposals                     = "noFundedProposals"
+	StrBudgetRemaining                       = "budgetRemaining"
+	StrAllAccounts                           = "allAccounts"
+	StrTicketBuyerHistory                    = "ticketBuyerHistory"
+	StrNoTicketBuyerSessions                 = "noTicketBuyerSessions"
+	StrTicketBuyerSessionRunning             = "ticketBuyerSessionRunning"
+	StrTicketBuyerSessionStart               = "ticketBuyerSessionStart"
+	StrTicketBuyerSessionStop                = "ticketBuyerSessionStop"
+	StrResumeTicketBuyerWarning              = "resumeTicketBuyerWarning"
+	StrVerifyReceipts                        = "verifyReceipts"
+	StrPasteVoteReceipts                     = "pasteVoteReceipts"
+	StrImportedVoteReceiptsMsg               = "importedVoteReceiptsMsg"
+	StrDisableSoloVotingWarning              = "disableSoloVotingWarning"
+	StrSoloVotingPassRequired                = "soloVotingPassRequired"
+	StrWalletVoteDetailsErr                  = "walletVoteDetailsErr"
+	StrExportUnsignedTx                      = "exportUnsignedTx"
+	StrImportTransaction                     = "importTransaction"
+	StrUnsignedTxExported                    = "unsignedTxExported"
+	StrTxFileOrData                          = "txFileOrData"
+	StrSignedTxExported                      = "signedTxExported"
+	StrExportMissingFiatValues               = "exportMissingFiatValues"
 )